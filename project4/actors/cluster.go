@@ -0,0 +1,278 @@
+package actors
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/cluster/clusterproviders/automanaged"
+	"github.com/asynkron/protoactor-go/cluster/identitylookup/disthash"
+	"github.com/asynkron/protoactor-go/remote"
+	"github.com/tejasriramparvathaneni/reddit_clone/journal"
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+)
+
+// grainPrefix starts the IDs of grain proxies, see grainProcess
+const grainPrefix = "grain/"
+
+// registrationTimeout bounds how long a grain waits for the engine to register a new entry
+const registrationTimeout = 5 * time.Second
+
+// ClusterConfig places a node in a cluster whose members find each other with the
+// automanaged provider: each member serves its status over HTTP on ManagePort and polls
+// the others, so no external service is needed
+type ClusterConfig struct {
+	Name string
+	// Host and port the node's actors are reached on
+	Host string
+	Port int
+	// ManagePort serves this member's status; Members lists host:ManagePort of every member,
+	// this one included
+	ManagePort int
+	Members    []string
+}
+
+// Node is one member of an engine cluster. Every node hosts user, subreddit and post grains,
+// placed by identity across the members; one node also runs the EngineActor, which remains
+// the directory of users, subreddits, posts and sessions. Comments stay children of their
+// post grain.
+type Node struct {
+	config engineConfig
+	env    *Env
+}
+
+func NewNode(opts ...EngineOption) *Node {
+	config := newEngineConfig(opts)
+	env := config.newEnv()
+	for kind, provider := range env.Journals {
+		env.Journals[kind] = journal.WithNames(provider, grainJournalName(kind))
+	}
+	return &Node{config: config, env: env}
+}
+
+// Start joins the cluster and starts remoting on config.Host and config.Port. Nodes without
+// the engine pass the engine's PID; the engine's own node passes nil and spawns EngineProps.
+func (node *Node) Start(system *actor.ActorSystem, config ClusterConfig, enginePID *actor.PID) *cluster.Cluster {
+	provider := automanaged.NewWithConfig(2*time.Second, config.ManagePort, config.Members...)
+	clusterConfig := cluster.Configure(config.Name, provider, disthash.New(),
+		remote.Configure(config.Host, config.Port), cluster.WithKinds(node.kinds()...))
+
+	node.env.Cluster = cluster.New(system, clusterConfig)
+	node.env.EnginePID = enginePID
+	node.env.grainWatcher = system.Root.Spawn(actor.PropsFromProducer(newGrainWatcher))
+	node.env.Cluster.StartMember()
+	return node.env.Cluster
+}
+
+// EngineProps returns the props to spawn the EngineActor, or with WithShards the ShardRouter,
+// with on this node
+func (node *Node) EngineProps() *actor.Props {
+	return node.config.engineProps(node.env)
+}
+
+// kinds are activated without constructor arguments; the actors take their identity from
+// the ClusterInit they are sent on activation, and posts are sent their summary by the subreddit
+func (node *Node) kinds() []*cluster.Kind {
+	env := node.env
+	return []*cluster.Kind{
+		cluster.NewKind(string(KindUser), env.props(KindUser, func() actor.Actor {
+			return NewUserActor("", env)
+		})),
+		cluster.NewKind(string(KindSubreddit), env.props(KindSubreddit, func() actor.Actor {
+			return NewSubredditActor("", env)
+		})),
+		cluster.NewKind(string(KindPost), env.props(KindPost, func() actor.Actor {
+			return NewPostActor(&proto.Post{}, env)
+		})),
+	}
+}
+
+// grainJournalName files an actor under its kind and identity rather than its activation's
+// PID, e.g. partition-activator/alice$3 becomes user/alice, so a grain recovers wherever it
+// is activated. That takes a journal every member shares: nodes without the engine reach the
+// engine's through a journal.RemoteProvider.
+func grainJournalName(kind ActorKind) func(string) string {
+	return func(name string) string {
+		segments := strings.Split(strings.TrimPrefix(name, disthash.PartitionActivatorActorName+"/"), "/")
+		for i, segment := range segments {
+			if cut := strings.LastIndex(segment, "$"); cut > 0 {
+				segments[i] = segment[:cut]
+			}
+		}
+		return string(kind) + "/" + strings.Join(segments, "/")
+	}
+}
+
+// spawnGrain spawns a child actor of kind called name, or in cluster mode returns the proxy
+// of the grain with identity, which the cluster activates on the node that owns it
+func (env *Env) spawnGrain(context actor.Context, kind ActorKind, identity, name string, producer actor.Producer) (*actor.PID, error) {
+	if env.Cluster == nil {
+		return context.SpawnNamed(env.props(kind, producer), name)
+	}
+	return env.grainPID(kind, identity), nil
+}
+
+// registerWithEngine sends the engine a PostCreated or CommentCreated, then runs done to answer
+// the client. Remoting only keeps messages between the same two processes in order, so in
+// cluster mode done waits for the engine to confirm; otherwise a client acting on the answer
+// could reach the engine before the registration does.
+func (env *Env) registerWithEngine(context actor.Context, registration interface{}, done func()) {
+	if env.Cluster == nil {
+		context.Send(env.EnginePID, registration)
+		done()
+		return
+	}
+	future := context.RequestFuture(env.EnginePID, registration, registrationTimeout)
+	context.ReenterAfter(future, func(_ interface{}, err error) {
+		if err != nil {
+			fmt.Printf("Engine did not confirm %T: %v\n", registration, err)
+		}
+		done()
+	})
+}
+
+// grainPID returns this node's proxy for a grain, registering it on first use
+func (env *Env) grainPID(kind ActorKind, identity string) *actor.PID {
+	process := &grainProcess{cluster: env.Cluster, watcher: env.grainWatcher, kind: kind, identity: identity}
+	pid, _ := env.Cluster.ActorSystem.ProcessRegistry.Add(process, grainPrefix+string(kind)+"/"+identity)
+	return pid
+}
+
+// pid rebuilds a PID of one of the engine's actors, received in a message or recovered from
+// the journal. Outside cluster mode they all run in this process, which may have restarted on
+// another address since the PID was journaled, so the PID is moved to this process's address.
+// In cluster mode grain proxies of other nodes are swapped for this node's own, so messages go
+// straight to the grain instead of through the node that sent it.
+func (env *Env) pid(context actor.Context, pid *proto.PID) *actor.PID {
+	if env.Cluster == nil {
+		return actor.NewPID(context.ActorSystem().Address(), pid.Id)
+	}
+	if strings.HasPrefix(pid.Id, grainPrefix) {
+		kind, identity, _ := strings.Cut(strings.TrimPrefix(pid.Id, grainPrefix), "/")
+		return env.grainPID(ActorKind(kind), identity)
+	}
+	return actor.NewPID(pid.Address, pid.Id)
+}
+
+// grainProcess stands in for a grain at a PID that does not change. The cluster moves grains
+// between nodes as members come and go, so actors keep the proxy's PID, and the proxy passes
+// messages on to the grain's current activation. It looks the activation up off the sender's
+// goroutine, holding messages in order until the lookup is done, and keeps it until the
+// grainWatcher reports it terminated.
+type grainProcess struct {
+	cluster  *cluster.Cluster
+	watcher  *actor.PID
+	kind     ActorKind
+	identity string
+
+	mutex      sync.Mutex
+	activation *actor.PID
+	looking    bool
+	pending    []grainMessage
+}
+
+type grainMessage struct {
+	message interface{}
+	system  bool
+}
+
+func (process *grainProcess) SendUserMessage(_ *actor.PID, message interface{}) {
+	process.send(grainMessage{message: message})
+}
+
+func (process *grainProcess) SendSystemMessage(_ *actor.PID, message interface{}) {
+	process.send(grainMessage{message: message, system: true})
+}
+
+// Stop leaves the grain alone; the cluster deactivates grains itself
+func (process *grainProcess) Stop(_ *actor.PID) {}
+
+func (process *grainProcess) send(message grainMessage) {
+	process.mutex.Lock()
+	defer process.mutex.Unlock()
+	if process.activation != nil {
+		process.deliver(process.activation, message)
+		return
+	}
+	process.pending = append(process.pending, message)
+	if !process.looking {
+		process.looking = true
+		go process.lookup()
+	}
+}
+
+// lookup asks the cluster for the grain's activation and passes on the messages held meanwhile;
+// they are delivered under the mutex so none sent later can overtake them
+func (process *grainProcess) lookup() {
+	pid := process.cluster.Get(process.identity, string(process.kind))
+
+	process.mutex.Lock()
+	defer process.mutex.Unlock()
+	pending := process.pending
+	process.pending = nil
+	process.looking = false
+	if pid == nil {
+		for _, message := range pending {
+			fmt.Printf("Grain %s %s could not be activated, dropping %T\n", process.kind, process.identity, actor.UnwrapEnvelopeMessage(message.message))
+		}
+		return
+	}
+	process.activation = pid
+	process.cluster.ActorSystem.Root.Send(process.watcher, &watchActivation{pid: pid, process: process})
+	for _, message := range pending {
+		process.deliver(pid, message)
+	}
+}
+
+func (process *grainProcess) deliver(pid *actor.PID, message grainMessage) {
+	target, _ := process.cluster.ActorSystem.ProcessRegistry.Get(pid)
+	if message.system {
+		target.SendSystemMessage(pid, message.message)
+	} else {
+		target.SendUserMessage(pid, message.message)
+	}
+}
+
+// forget drops the activation once it terminated, so the next message looks the grain up again
+func (process *grainProcess) forget(pid *actor.PID) {
+	process.mutex.Lock()
+	defer process.mutex.Unlock()
+	if process.activation != nil && process.activation.Equal(pid) {
+		process.activation = nil
+	}
+}
+
+type watchActivation struct {
+	pid     *actor.PID
+	process *grainProcess
+}
+
+// grainWatcher watches the activations grain proxies pass messages to, and tells the proxies
+// when one terminates, whether it stopped or its node left the cluster
+type grainWatcher struct {
+	proxies map[string][]*grainProcess
+}
+
+func newGrainWatcher() actor.Actor {
+	return &grainWatcher{proxies: make(map[string][]*grainProcess)}
+}
+
+func (state *grainWatcher) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *watchActivation:
+		key := msg.pid.String()
+		if len(state.proxies[key]) == 0 {
+			context.Watch(msg.pid)
+		}
+		state.proxies[key] = append(state.proxies[key], msg.process)
+	case *actor.Terminated:
+		key := msg.Who.String()
+		for _, process := range state.proxies[key] {
+			process.forget(msg.Who)
+		}
+		delete(state.proxies, key)
+	}
+}
@@ -5,59 +5,279 @@ import (
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/persistence"
 	"github.com/tejasriramparvathaneni/reddit_clone/proto"
 )
 
 type CommentActor struct {
+	persistence.Mixin
 	Content   string
 	Author    string
 	Timestamp int64
 	Upvotes   int32
 	Downvotes int32
+	Votes     map[string]int32
 	Replies   map[string]*actor.PID
+	ReplyIDs  []string
 	CommentID string
+	PostID    string
+	PostPID   *actor.PID
+	// Replies as they were added, kept for snapshots
+	AddedReplies []*proto.CommentAdded
+	env          *Env
 }
 
-func NewCommentActor(content, author, commentID string) actor.Actor {
+// NewCommentActor starts from the event that added the comment to its post or parent comment
+func NewCommentActor(comment *proto.CommentAdded, postPID *actor.PID, env *Env) actor.Actor {
 	return &CommentActor{
-		Content:   content,
-		Author:    author,
-		Timestamp: time.Now().Unix(),
+		Content:   comment.Content,
+		Author:    comment.Author,
+		Timestamp: comment.Timestamp,
 		Upvotes:   0,
 		Downvotes: 0,
+		Votes:     make(map[string]int32),
 		Replies:   make(map[string]*actor.PID),
-		CommentID: commentID,
+		CommentID: comment.CommentId,
+		PostID:    comment.PostId,
+		PostPID:   postPID,
+		env:       env,
 	}
 }
 
 func (state *CommentActor) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
+	case *actor.Started, *persistence.ReplayComplete:
+	case *actor.Restarting, *actor.Stopping, *actor.Stopped, *actor.Terminated:
+	case *persistence.RequestSnapshot:
+		state.PersistSnapshot(state.snapshot())
+	case *proto.CommentSnapshot:
+		state.restoreSnapshot(context, msg)
+	case *proto.VoteCast:
+		state.applyVoteCast(msg)
+	case *proto.CommentAdded:
+		state.applyReplyAdded(context, msg)
 	case *proto.CommentOnComment:
 		state.handleCommentOnComment(context, msg)
 	case *proto.VoteOnComment:
 		state.handleVoteOnComment(context, msg)
+	case *proto.GetCommentTree:
+		state.handleGetCommentTree(context, msg)
 	default:
 		fmt.Printf("CommentActor received a message: %T\n", msg)
 	}
 }
 
+func (state *CommentActor) snapshot() *proto.CommentSnapshot {
+	return &proto.CommentSnapshot{
+		Replies: append([]*proto.CommentAdded{}, state.AddedReplies...),
+		Votes:   votesSnapshot(state.Votes),
+	}
+}
+
+func (state *CommentActor) restoreSnapshot(context actor.Context, snapshot *proto.CommentSnapshot) {
+	for _, reply := range snapshot.Replies {
+		state.applyReplyAdded(context, reply)
+	}
+	for _, vote := range snapshot.Votes {
+		state.applyVoteCast(vote)
+	}
+}
+
 func (state *CommentActor) handleCommentOnComment(context actor.Context, msg *proto.CommentOnComment) {
-	replyCommentID := fmt.Sprintf("%s_%d", state.CommentID, len(state.Replies)+1)
+	sender := context.Sender()
+	event := &proto.CommentAdded{
+		CommentId: fmt.Sprintf("%s_%d", state.CommentID, len(state.Replies)+1),
+		PostId:    state.PostID,
+		Author:    msg.Author,
+		Content:   msg.Content,
+		Timestamp: state.env.Clock.Now().Unix(),
+	}
+	state.PersistReceive(event)
+	replyPID := state.applyReplyAdded(context, event)
+	if replyPID == nil {
+		context.Send(sender, &proto.CommentResponse{
+			Success: false,
+			Message: "Failed to create reply",
+		})
+		return
+	}
+	replyCommentID := event.CommentId
+
+	fmt.Printf("Client %s replied to comment %s by %s\n", msg.Author, state.CommentID, state.Author)
 
-	replyProps := actor.PropsFromProducer(func() actor.Actor {
-		return NewCommentActor(msg.Content, msg.Author, replyCommentID)
+	// Both the post's comment index and the engine registry learn about the reply
+	// before the caller does, so a reply to this reply can be routed right away
+	created := &proto.CommentCreated{
+		CommentId:  replyCommentID,
+		PostId:     state.PostID,
+		Author:     msg.Author,
+		CommentPid: &proto.PID{Address: replyPID.Address, Id: replyPID.Id},
+		Content:    msg.Content,
+		PostPid:    &proto.PID{Address: state.PostPID.Address, Id: state.PostPID.Id},
+	}
+	context.Send(state.PostPID, created)
+	state.env.registerWithEngine(context, created, func() {
+		context.Send(sender, &proto.CommentResponse{
+			Success:   true,
+			Message:   "Reply created",
+			CommentId: replyCommentID,
+		})
 	})
-	replyPID := context.Spawn(replyProps)
-	state.Replies[replyCommentID] = replyPID
+}
 
-	fmt.Printf("Client %s replied to comment %s by %s\n", msg.Author, state.CommentID, state.Author)
+func (state *CommentActor) applyReplyAdded(context actor.Context, event *proto.CommentAdded) *actor.PID {
+	replyProps := state.env.props(KindComment, func() actor.Actor {
+		return NewCommentActor(event, state.PostPID, state.env)
+	})
+	replyPID, err := context.SpawnNamed(replyProps, event.CommentId)
+	if err != nil {
+		fmt.Printf("Failed to spawn comment actor for %s: %v\n", event.CommentId, err)
+		return nil
+	}
+	state.Replies[event.CommentId] = replyPID
+	state.ReplyIDs = append(state.ReplyIDs, event.CommentId)
+	state.AddedReplies = append(state.AddedReplies, event)
+	return replyPID
 }
 
-func (state *CommentActor) handleVoteOnComment(_ actor.Context, msg *proto.VoteOnComment) {
-	if msg.Upvote {
-		state.Upvotes++
-	} else {
-		state.Downvotes++
+func (state *CommentActor) handleVoteOnComment(context actor.Context, msg *proto.VoteOnComment) {
+	sender := context.Sender()
+	event := &proto.VoteCast{
+		Voter: msg.Voter,
+		Value: voteValue(msg.Upvote, msg.Retract),
+	}
+	// Repeating a vote, or retracting one never cast, changes nothing and is not journaled
+	if state.Votes[msg.Voter] != event.Value {
+		state.PersistReceive(event)
+		state.notifyAuthorKarma(context, state.applyVoteCast(event))
 	}
 	fmt.Printf("Client %s voted on comment %s by %s\n", msg.Voter, state.CommentID, state.Author)
+
+	context.Send(sender, &proto.VoteResponse{
+		Success:   true,
+		Message:   "Vote recorded",
+		Upvotes:   state.Upvotes,
+		Downvotes: state.Downvotes,
+	})
+}
+
+func (state *CommentActor) handleGetCommentTree(context actor.Context, msg *proto.GetCommentTree) {
+	sender := context.Sender()
+	node := &proto.CommentNode{
+		CommentId: state.CommentID,
+		Author:    state.Author,
+		Content:   state.Content,
+		Upvotes:   state.Upvotes,
+		Downvotes: state.Downvotes,
+		Score:     state.Upvotes - state.Downvotes,
+		Timestamp: state.Timestamp,
+	}
+
+	if msg.MaxDepth == 1 {
+		node.MoreReplies = int32(len(state.ReplyIDs))
+		context.Respond(node)
+		return
+	}
+
+	page, more := pageCommentIDs(state.ReplyIDs, msg.After, msg.Limit)
+	deadline := commentTreeDeadline
+	if msg.DeadlineMs > 0 {
+		deadline = time.Duration(msg.DeadlineMs) * time.Millisecond
+	}
+	childRequest := &proto.GetCommentTree{
+		MaxDepth:   childDepth(msg.MaxDepth),
+		Limit:      msg.Limit,
+		DeadlineMs: replyDeadline(deadline).Milliseconds(),
+	}
+	collectCommentTrees(context, state.Replies, page, childRequest, func(replies []*proto.CommentNode, timedOut []string) {
+		node.Replies = replies
+		node.MoreReplies = more
+		node.TimedOutReplies = timedOut
+		context.Send(sender, node)
+	})
+}
+
+func (state *CommentActor) applyVoteCast(event *proto.VoteCast) int32 {
+	return applyVote(state.Votes, &state.Upvotes, &state.Downvotes, event.Voter, event.Value)
+}
+
+func (state *CommentActor) notifyAuthorKarma(context actor.Context, amount int32) {
+	updateKarmaMsg := &proto.UpdateKarma{
+		Username: state.Author,
+		Amount:   amount,
+		Kind:     proto.KarmaKind_COMMENT_KARMA,
+	}
+	context.Send(state.env.EnginePID, updateKarmaMsg)
+}
+
+// pageCommentIDs returns up to limit IDs following after (or from the start when after is
+// empty) and how many IDs remain after the page
+func pageCommentIDs(ids []string, after string, limit int32) ([]string, int32) {
+	start := 0
+	if after != "" {
+		for i, id := range ids {
+			if id == after {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	page := ids[start:]
+	if limit > 0 && int(limit) < len(page) {
+		page = page[:limit]
+	}
+	return page, int32(len(ids) - start - len(page))
+}
+
+func childDepth(maxDepth int32) int32 {
+	if maxDepth == 0 {
+		return 0
+	}
+	return maxDepth - 1
+}
+
+// commentTreeDeadline bounds how long a post waits for its comment trees. Every level below
+// waits commentTreeMargin less for its replies than it was given, so a slow reply is left out
+// by its parent instead of making the whole subtree miss the deadline.
+const (
+	commentTreeDeadline = 2 * time.Second
+	commentTreeMargin   = 100 * time.Millisecond
+)
+
+// replyDeadline is how long a comment given deadline to answer waits for its replies
+func replyDeadline(deadline time.Duration) time.Duration {
+	if deadline <= 2*commentTreeMargin {
+		return deadline / 2
+	}
+	return deadline - commentTreeMargin
+}
+
+// collectCommentTrees asks every comment in ids for its subtree at once without blocking the
+// actor, so a tree is assembled in one round-trip per level. done runs with the subtrees in
+// the order of ids and the IDs of the comments that missed msg's deadline.
+func collectCommentTrees(context actor.Context, pids map[string]*actor.PID, ids []string, msg *proto.GetCommentTree, done func(nodes []*proto.CommentNode, timedOut []string)) {
+	targets := make(map[string]*actor.PID, len(ids))
+	for _, id := range ids {
+		if pid, ok := pids[id]; ok {
+			targets[id] = pid
+		}
+	}
+
+	gatherReplies(context, targets, msg, time.Duration(msg.DeadlineMs)*time.Millisecond, func(replies map[string]interface{}, missed []string) {
+		late := make(map[string]bool, len(missed))
+		for _, id := range missed {
+			late[id] = true
+		}
+		nodes := make([]*proto.CommentNode, 0, len(ids))
+		var timedOut []string
+		for _, id := range ids {
+			if late[id] {
+				timedOut = append(timedOut, id)
+			} else if node, ok := replies[id].(*proto.CommentNode); ok {
+				nodes = append(nodes, node)
+			}
+		}
+		done(nodes, timedOut)
+	})
 }
@@ -1,39 +1,284 @@
 package actors
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/persistence"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
+	"github.com/tejasriramparvathaneni/reddit_clone/journal"
 	"github.com/tejasriramparvathaneni/reddit_clone/models"
 	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+	"github.com/tejasriramparvathaneni/reddit_clone/storage"
+	"github.com/tejasriramparvathaneni/reddit_clone/utils"
 )
 
 func init() {
 	log.SetFormatter(&log.JSONFormatter{})
 }
 
+const sessionTTL = 24 * time.Hour
+
+// sessionSweepInterval is how often logins drop the sessions that expired unused
+const sessionSweepInterval = time.Hour
+
 type EngineActor struct {
-	users         map[string]*models.User
-	subreddits    map[string]*models.Subreddit
-	totalMessages int64
+	persistence.Mixin
+	users      map[string]*models.User
+	subreddits map[string]*models.Subreddit
+	posts      map[string]*models.Post
+	comments   map[string]*models.Comment
+	sessions   map[string]*models.Session
+	presence   map[string]*proto.Presence
+	config     engineConfig
+	env        *Env
+	// Set on the shards of a sharded engine, see ShardRouter
+	shard  string
+	router *actor.PID
+	// Saves the directory and direct messages to the repository, when there is one
+	storage *actor.PID
+	// Directory sizes last added to the gauges, which the shards share
+	gauged [4]int
+	// Set once the instance is discarded, so its entries are taken off the gauges
+	discarded bool
+	// When expired sessions were last dropped, see sweepSessions
+	sessionsSwept time.Time
+}
+
+type engineConfig struct {
+	passwordCost      int
+	fanoutLimit       int
+	journal           persistence.Provider
+	snapshotIntervals map[ActorKind]int
+	repository        storage.Repository
+	clock             utils.Clock
+	metrics           *Metrics
+	shards            int
+	supervision       map[ActorKind]SupervisionPolicy
+}
+
+type EngineOption func(*engineConfig)
+
+// WithPasswordCost sets the bcrypt cost for new password hashes.
+// Existing hashes with a different cost are upgraded on the user's next login.
+func WithPasswordCost(cost int) EngineOption {
+	return func(config *engineConfig) {
+		config.passwordCost = cost
+	}
+}
+
+// WithFanoutLimit sets the member count above which a subreddit stops pushing new posts to
+// member timelines
+func WithFanoutLimit(limit int) EngineOption {
+	return func(config *engineConfig) {
+		config.fanoutLimit = limit
+	}
+}
+
+// WithJournal sets where every actor persists its events. Without it the journal is kept in
+// memory and does not survive a restart of the process.
+func WithJournal(provider persistence.Provider) EngineOption {
+	return func(config *engineConfig) {
+		config.journal = provider
+	}
+}
+
+// WithRepository sets where users, subreddits, posts, comments and direct messages are
+// stored for querying outside the actors. Without it they are only kept by the actors and
+// the journal. They are saved in the background as they happen and not again when the
+// journal is replayed, so a repository kept in memory only holds what happened since the
+// engine started.
+func WithRepository(repository storage.Repository) EngineOption {
+	return func(config *engineConfig) {
+		config.repository = repository
+	}
+}
+
+// WithMetrics reports to metrics instead of a registry nobody scrapes
+func WithMetrics(metrics *Metrics) EngineOption {
+	return func(config *engineConfig) {
+		config.metrics = metrics
+	}
+}
+
+// WithShards splits the engine into shards behind a ShardRouter. Shards are never removed:
+// a journal with more shards keeps them all, and missing shards are added one at a time.
+func WithShards(shards int) EngineOption {
+	return func(config *engineConfig) {
+		config.shards = shards
+	}
+}
+
+// WithSupervision sets how often actors of kind may crash and be restarted before the failure
+// is escalated to their parent
+func WithSupervision(kind ActorKind, policy SupervisionPolicy) EngineOption {
+	return func(config *engineConfig) {
+		config.supervision[kind] = policy
+	}
+}
+
+// WithClock sets the clock actors read timestamps from, so a simulation can be replayed
+func WithClock(clock utils.Clock) EngineOption {
+	return func(config *engineConfig) {
+		config.clock = clock
+	}
+}
+
+// WithSnapshotInterval sets how many events actors of kind journal between snapshots,
+// overriding the journal's own interval
+func WithSnapshotInterval(kind ActorKind, interval int) EngineOption {
+	return func(config *engineConfig) {
+		config.snapshotIntervals[kind] = interval
+	}
+}
+
+func newEngineConfig(opts []EngineOption) engineConfig {
+	config := engineConfig{
+		passwordCost:      utils.DefaultPasswordCost,
+		fanoutLimit:       DefaultFanoutLimit,
+		snapshotIntervals: make(map[ActorKind]int),
+		supervision:       make(map[ActorKind]SupervisionPolicy),
+		clock:             utils.SystemClock{},
+	}
+	for kind, policy := range defaultSupervisionPolicies {
+		config.supervision[kind] = policy
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.metrics == nil {
+		config.metrics = NewMetrics(prometheus.NewRegistry())
+	}
+	if config.journal == nil {
+		config.journal = journal.NewMemoryProvider(journal.DefaultSnapshotInterval)
+	}
+	return config
+}
+
+func (config engineConfig) newEnv() *Env {
+	journals := make(map[ActorKind]persistence.Provider)
+	for _, kind := range actorKinds {
+		journals[kind] = config.journal
+		if interval := config.snapshotIntervals[kind]; interval > 0 {
+			journals[kind] = journal.WithSnapshotInterval(config.journal, interval)
+		}
+	}
+	// The router is spawned as "engine" too, but must not replay an unsharded engine's journal
+	journals[KindRouter] = journal.WithNames(journals[KindRouter], func(name string) string {
+		return name + "/router"
+	})
+	recovery := NewRecoveryStats()
+	if err := config.metrics.registerer.Register(recovery); err != nil {
+		fmt.Printf("Recovery stats are not exported: %v\n", err)
+	}
+	return &Env{
+		Journals:    journals,
+		FanoutLimit: config.fanoutLimit,
+		Recovery:    recovery,
+		Metrics:     config.metrics,
+		Supervision: newSupervision(config.supervision, config.metrics),
+		Clock:       config.clock,
+	}
+}
+
+// NewEngineActor must be spawned with the persistence middleware; NewEngineProps sets it up
+func NewEngineActor(opts ...EngineOption) actor.Actor {
+	config := newEngineConfig(opts)
+	return newEngineActor(config, config.newEnv())
 }
 
-func NewEngineActor() actor.Actor {
+func newEngineActor(config engineConfig, env *Env) *EngineActor {
 	engine := &EngineActor{
 		users:      make(map[string]*models.User),
 		subreddits: make(map[string]*models.Subreddit),
+		posts:      make(map[string]*models.Post),
+		comments:   make(map[string]*models.Comment),
+		sessions:   make(map[string]*models.Session),
+		presence:   make(map[string]*proto.Presence),
+		config:     config,
+		env:        env,
 	}
-	engine.startMetricsLogger()
 	return engine
 }
 
+// newEngineShard creates one shard of a sharded engine, spawned by router
+func newEngineShard(config engineConfig, env *Env, shard string, router *actor.PID) *EngineActor {
+	engine := newEngineActor(config, env)
+	engine.shard = shard
+	engine.router = router
+	return engine
+}
+
+// NewEngineProps returns the props to spawn the EngineActor with, recovering it and the
+// actors below it from the journal. With WithShards they spawn a ShardRouter instead.
+func NewEngineProps(opts ...EngineOption) *actor.Props {
+	config := newEngineConfig(opts)
+	return config.engineProps(config.newEnv())
+}
+
+func (config engineConfig) engineProps(env *Env) *actor.Props {
+	if config.shards > 0 {
+		return env.rootProps(KindRouter, func() actor.Actor {
+			return newShardRouter(config, env)
+		})
+	}
+	return env.rootProps(KindEngine, func() actor.Actor {
+		return newEngineActor(config, env)
+	})
+}
+
 func (state *EngineActor) Receive(context actor.Context) {
-	state.totalMessages++
+	defer state.updateGauges()
 	switch msg := context.Message().(type) {
+	case *actor.Started:
+		if state.router == nil {
+			state.env.EnginePID = context.Self()
+		}
+		if state.config.repository != nil {
+			state.storage = context.Spawn(actor.PropsFromProducer(func() actor.Actor {
+				return NewStorageWriter(state.config.repository)
+			}))
+		}
+	case *actor.Restarting, *actor.Stopping, *actor.Stopped:
+		// A restarted engine counts its entries again once it replayed them
+		state.discarded = true
+	case *persistence.RequestSnapshot:
+		state.PersistSnapshot(state.snapshot())
+	case *proto.EngineSnapshot:
+		state.restoreSnapshot(context, msg)
+	case *persistence.ReplayComplete:
+		state.handleReplayComplete(context)
+	case *handOffKeys:
+		state.handleHandOffKeys(context, msg)
+	case *proto.KeysHandedOff:
+		state.applyKeysHandedOff(msg)
+	case *proto.ShardHandOff:
+		state.handleShardHandOff(context, msg)
+	case *proto.CompactJournals:
+		state.config.compactJournals(context)
+	case *proto.GetRecoveryStats:
+		context.Respond(state.env.Recovery.Snapshot())
+	case *proto.UserRegistered:
+		state.applyUserRegistered(context, msg)
+	case *proto.PasswordChanged:
+		state.applyPasswordChanged(context, msg)
+	case *proto.SubredditCreated:
+		state.applySubredditCreated(context, msg)
 	case *proto.RegisterUser:
 		state.handleRegisterUser(context, msg)
+	case *proto.AuthenticateUser:
+		state.handleAuthenticateUser(context, msg)
+	case *proto.ValidateSession:
+		state.handleValidateSession(context, msg)
+	case *proto.Logout:
+		state.handleLogout(context, msg)
+	case *proto.ChangePassword:
+		state.handleChangePassword(context, msg)
 	case *proto.CreateSubreddit:
 		state.handleCreateSubreddit(context, msg)
 	case *proto.JoinSubreddit:
@@ -42,14 +287,42 @@ func (state *EngineActor) Receive(context actor.Context) {
 		state.handleLeaveSubreddit(context, msg)
 	case *proto.PostToSubreddit:
 		state.handlePostToSubreddit(context, msg)
+	case *proto.PostCreated:
+		state.handlePostCreated(context, msg)
+	case *proto.CommentCreated:
+		state.handleCommentCreated(context, msg)
+	case *proto.CommentOnPost:
+		state.handleCommentOnPost(context, msg)
+	case *proto.VoteOnPost:
+		state.handleVoteOnPost(context, msg)
+	case *proto.CommentOnComment:
+		state.handleCommentOnComment(context, msg)
+	case *proto.VoteOnComment:
+		state.handleVoteOnComment(context, msg)
+	case *proto.Repost:
+		state.handleRepost(context, msg)
+	case *proto.GetPostWithComments:
+		state.handleGetPostWithComments(context, msg)
+	case *proto.GetComment:
+		state.handleGetComment(context, msg)
 	case *proto.SendDirectMessage:
 		state.handleSendDirectMessage(context, msg)
 	case *proto.GetInbox:
 		state.handleGetInbox(context, msg)
 	case *proto.GetFeed:
 		state.handleGetFeed(context, msg)
+	case *proto.GetSubredditPosts:
+		state.handleGetSubredditPosts(context, msg)
 	case *proto.UpdateKarma:
 		state.handleUpdateKarma(context, msg)
+	case *proto.GetUserProfile:
+		state.handleGetUserProfile(context, msg)
+	case *proto.Connect:
+		state.handleConnect(context, msg)
+	case *proto.Disconnect:
+		state.handleDisconnect(context, msg)
+	case *proto.GetPresence:
+		state.handleGetPresence(context, msg)
 	default:
 		log.WithFields(log.Fields{
 			"actor":   "EngineActor",
@@ -59,32 +332,268 @@ func (state *EngineActor) Receive(context actor.Context) {
 }
 
 func (state *EngineActor) handleRegisterUser(context actor.Context, msg *proto.RegisterUser) {
+	sender := context.Sender()
 	if _, exists := state.users[msg.Username]; exists {
 		response := &proto.RegistrationResponse{
 			Success: false,
 			Message: "Username already exists",
 		}
-		context.Respond(response)
+		context.Send(sender, response)
 		return
 	}
 
-	userProps := actor.PropsFromProducer(func() actor.Actor {
-		return NewUserActor(msg.Username)
+	if err := utils.ValidatePassword(msg.Password); err != nil {
+		context.Send(sender, &proto.RegistrationResponse{
+			Success: false,
+			Message: fmt.Sprintf("Invalid password: %v", err),
+		})
+		return
+	}
+
+	state.hashPassword(context, msg.Password, func(passwordHash string, err error) {
+		if err != nil {
+			context.Send(sender, &proto.RegistrationResponse{
+				Success: false,
+				Message: fmt.Sprintf("Invalid password: %v", err),
+			})
+			return
+		}
+		// The name may have been taken while the password was hashed
+		if _, exists := state.users[msg.Username]; exists {
+			context.Send(sender, &proto.RegistrationResponse{
+				Success: false,
+				Message: "Username already exists",
+			})
+			return
+		}
+
+		event := &proto.UserRegistered{
+			Username:     msg.Username,
+			PasswordHash: passwordHash,
+		}
+		state.PersistReceive(event)
+		state.applyUserRegistered(context, event)
+
+		response := &proto.RegistrationResponse{
+			Success: true,
+			Message: "Registration successful",
+		}
+		context.Send(sender, response)
 	})
-	userPID := context.Spawn(userProps)
+}
+
+func (state *EngineActor) applyUserRegistered(context actor.Context, event *proto.UserRegistered) {
+	userPID, err := state.spawnDirectoryActor(context, KindUser, event.Username, "user-"+event.Username, func() actor.Actor {
+		return NewUserActor(event.Username, state.env)
+	})
+	if err != nil {
+		fmt.Printf("Failed to spawn user actor for %s: %v\n", event.Username, err)
+		return
+	}
 
 	user := &models.User{
-		Username: msg.Username,
-		Password: msg.Password,
-		PID:      userPID,
+		Username:     event.Username,
+		PasswordHash: event.PasswordHash,
+		PID:          userPID,
+	}
+	state.users[event.Username] = user
+	state.store(context, *user)
+}
+
+func (state *EngineActor) applyPasswordChanged(context actor.Context, event *proto.PasswordChanged) {
+	if user, exists := state.users[event.Username]; exists {
+		user.PasswordHash = event.PasswordHash
+		state.store(context, *user)
+	}
+}
+
+func (state *EngineActor) handleAuthenticateUser(context actor.Context, msg *proto.AuthenticateUser) {
+	sender := context.Sender()
+	user, exists := state.users[msg.Username]
+	if !exists {
+		context.Send(sender, &proto.AuthenticationResponse{
+			Success: false,
+			Message: "Invalid username or password",
+		})
+		return
+	}
+
+	checkedHash := user.PasswordHash
+	state.checkPassword(context, checkedHash, msg.Password, func(ok bool) {
+		// The password may have been changed while it was checked
+		user, exists := state.users[msg.Username]
+		if !ok || !exists || user.PasswordHash != checkedHash {
+			context.Send(sender, &proto.AuthenticationResponse{
+				Success: false,
+				Message: "Invalid username or password",
+			})
+			return
+		}
+
+		if utils.NeedsRehash(user.PasswordHash, state.config.passwordCost) {
+			state.rehashPassword(context, user.Username, checkedHash, msg.Password)
+		}
+
+		token, err := newSessionToken(user.Username)
+		if err != nil {
+			fmt.Printf("Error creating session for user %s: %v\n", msg.Username, err)
+			context.Send(sender, &proto.AuthenticationResponse{
+				Success: false,
+				Message: "Could not create session",
+			})
+			return
+		}
+
+		state.sweepSessions()
+		state.sessions[token] = &models.Session{
+			Token:     token,
+			Username:  user.Username,
+			ExpiresAt: state.env.Clock.Now().Add(sessionTTL),
+		}
+
+		context.Send(sender, &proto.AuthenticationResponse{
+			Success: true,
+			Message: "Login successful",
+			Token:   token,
+		})
+	})
+}
+
+// rehashPassword hashes password again at the current cost, unless the user's hash is no
+// longer oldHash by the time it is done
+func (state *EngineActor) rehashPassword(context actor.Context, username, oldHash, password string) {
+	state.hashPassword(context, password, func(passwordHash string, err error) {
+		user, exists := state.users[username]
+		if err != nil || !exists || user.PasswordHash != oldHash {
+			return
+		}
+		event := &proto.PasswordChanged{
+			Username:     username,
+			PasswordHash: passwordHash,
+		}
+		state.PersistReceive(event)
+		state.applyPasswordChanged(context, event)
+	})
+}
+
+// sweepSessions drops the expired sessions, at most once per sessionSweepInterval. Sessions
+// are otherwise only dropped when they are validated after expiring, so one never used again
+// would be kept forever.
+func (state *EngineActor) sweepSessions() {
+	now := state.env.Clock.Now()
+	if now.Sub(state.sessionsSwept) < sessionSweepInterval {
+		return
+	}
+	state.sessionsSwept = now
+	for token, session := range state.sessions {
+		if now.After(session.ExpiresAt) {
+			delete(state.sessions, token)
+		}
+	}
+}
+
+func (state *EngineActor) handleValidateSession(context actor.Context, msg *proto.ValidateSession) {
+	session, exists := state.sessions[msg.Token]
+	if !exists {
+		context.Respond(&proto.SessionInfo{Valid: false})
+		return
+	}
+
+	if state.env.Clock.Now().After(session.ExpiresAt) {
+		delete(state.sessions, msg.Token)
+		context.Respond(&proto.SessionInfo{Valid: false})
+		return
+	}
+
+	context.Respond(&proto.SessionInfo{
+		Valid:    true,
+		Username: session.Username,
+	})
+}
+
+func (state *EngineActor) handleLogout(context actor.Context, msg *proto.Logout) {
+	if _, exists := state.sessions[msg.Token]; !exists {
+		context.Respond(&proto.AuthenticationResponse{
+			Success: false,
+			Message: "Session not found",
+		})
+		return
 	}
-	state.users[msg.Username] = user
 
-	response := &proto.RegistrationResponse{
+	delete(state.sessions, msg.Token)
+	context.Respond(&proto.AuthenticationResponse{
 		Success: true,
-		Message: "Registration successful",
+		Message: "Logged out",
+	})
+}
+
+func (state *EngineActor) handleChangePassword(context actor.Context, msg *proto.ChangePassword) {
+	sender := context.Sender()
+	user, exists := state.users[msg.Username]
+	if !exists {
+		context.Send(sender, &proto.PasswordChangeResponse{
+			Success: false,
+			Message: "Invalid username or password",
+		})
+		return
+	}
+
+	if err := utils.ValidatePassword(msg.NewPassword); err != nil {
+		context.Send(sender, &proto.PasswordChangeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Invalid password: %v", err),
+		})
+		return
 	}
-	context.Respond(response)
+
+	checkedHash := user.PasswordHash
+	state.checkPassword(context, checkedHash, msg.OldPassword, func(ok bool) {
+		if !ok {
+			context.Send(sender, &proto.PasswordChangeResponse{
+				Success: false,
+				Message: "Invalid username or password",
+			})
+			return
+		}
+
+		state.hashPassword(context, msg.NewPassword, func(passwordHash string, err error) {
+			if err != nil {
+				context.Send(sender, &proto.PasswordChangeResponse{
+					Success: false,
+					Message: fmt.Sprintf("Invalid password: %v", err),
+				})
+				return
+			}
+			// Another change may have replaced the old password meanwhile
+			user, exists := state.users[msg.Username]
+			if !exists || user.PasswordHash != checkedHash {
+				context.Send(sender, &proto.PasswordChangeResponse{
+					Success: false,
+					Message: "Invalid username or password",
+				})
+				return
+			}
+
+			event := &proto.PasswordChanged{
+				Username:     user.Username,
+				PasswordHash: passwordHash,
+			}
+			state.PersistReceive(event)
+			state.applyPasswordChanged(context, event)
+
+			// Sign out every other session that may have been opened with the old password
+			for token, session := range state.sessions {
+				if session.Username == msg.Username && token != msg.KeepToken {
+					delete(state.sessions, token)
+				}
+			}
+
+			context.Send(sender, &proto.PasswordChangeResponse{
+				Success: true,
+				Message: "Password changed",
+			})
+		})
+	})
 }
 
 func (state *EngineActor) handleCreateSubreddit(context actor.Context, msg *proto.CreateSubreddit) {
@@ -93,21 +602,265 @@ func (state *EngineActor) handleCreateSubreddit(context actor.Context, msg *prot
 		return
 	}
 
-	subredditProps := actor.PropsFromProducer(func() actor.Actor {
-		return NewSubredditActor(msg.Name, context.Self())
+	event := &proto.SubredditCreated{Name: msg.Name}
+	state.PersistReceive(event)
+	state.applySubredditCreated(context, event)
+
+	fmt.Printf("Subreddit %s created successfully\n", msg.Name)
+}
+
+func (state *EngineActor) applySubredditCreated(context actor.Context, event *proto.SubredditCreated) {
+	subredditPID, err := state.spawnDirectoryActor(context, KindSubreddit, event.Name, "subreddit-"+event.Name, func() actor.Actor {
+		return NewSubredditActor(event.Name, state.env)
 	})
-	subredditPID := context.Spawn(subredditProps)
+	if err != nil {
+		fmt.Printf("Failed to spawn subreddit actor for %s: %v\n", event.Name, err)
+		return
+	}
 
 	subreddit := &models.Subreddit{
-		Name: msg.Name,
+		Name: event.Name,
 		PID:  subredditPID,
 	}
-	state.subreddits[msg.Name] = subreddit
+	state.subreddits[event.Name] = subreddit
+	state.store(context, *subreddit)
+}
 
-	fmt.Printf("Subreddit %s created successfully\n", msg.Name)
+// store has the StorageWriter save a copy of entity, if there is a repository. Events being
+// replayed were stored when they first happened.
+func (state *EngineActor) store(context actor.Context, entity interface{}) {
+	if state.storage != nil && !state.Recovering() {
+		context.Send(state.storage, entity)
+	}
+}
+
+// spawnDirectoryActor spawns a user or subreddit actor as a child of the engine. Shards spawn
+// it at the root under the router's name, where it stays put when its entry moves to another
+// shard; the shard taking the entry over finds it already running.
+func (state *EngineActor) spawnDirectoryActor(context actor.Context, kind ActorKind, identity, name string, producer actor.Producer) (*actor.PID, error) {
+	if state.router == nil || state.env.Cluster != nil {
+		return state.env.spawnGrain(context, kind, identity, name, producer)
+	}
+	pid, err := context.ActorSystem().Root.SpawnNamed(state.env.rootProps(kind, producer), state.router.Id+"/"+name)
+	if errors.Is(err, actor.ErrNameExists) {
+		return pid, nil
+	}
+	return pid, err
+}
+
+func (state *EngineActor) snapshot() *proto.EngineSnapshot {
+	return state.directory(func(string) bool { return true })
+}
+
+// directory lists the users, subreddits, posts and comments whose key is kept
+func (state *EngineActor) directory(keep func(key string) bool) *proto.EngineSnapshot {
+	snapshot := &proto.EngineSnapshot{}
+	for _, user := range state.users {
+		if !keep(userKey(user.Username)) {
+			continue
+		}
+		snapshot.Users = append(snapshot.Users, &proto.UserRegistered{
+			Username:     user.Username,
+			PasswordHash: user.PasswordHash,
+		})
+	}
+	for name := range state.subreddits {
+		if !keep(subredditKey(name)) {
+			continue
+		}
+		snapshot.Subreddits = append(snapshot.Subreddits, &proto.SubredditCreated{Name: name})
+	}
+	for _, post := range state.posts {
+		if !keep(subredditKey(post.SubredditName)) {
+			continue
+		}
+		snapshot.Posts = append(snapshot.Posts, &proto.PostCreated{
+			PostId:        post.PostID,
+			SubredditName: post.SubredditName,
+			Author:        post.Author,
+			PostPid:       &proto.PID{Address: post.PID.Address, Id: post.PID.Id},
+			Content:       post.Content,
+		})
+	}
+	for _, comment := range state.comments {
+		if !keep(commentKey(comment.CommentID)) {
+			continue
+		}
+		created := &proto.CommentCreated{
+			CommentId:  comment.CommentID,
+			PostId:     comment.PostID,
+			Author:     comment.Author,
+			CommentPid: &proto.PID{Address: comment.PID.Address, Id: comment.PID.Id},
+			Content:    comment.Content,
+		}
+		if comment.PostPID != nil {
+			created.PostPid = &proto.PID{Address: comment.PostPID.Address, Id: comment.PostPID.Id}
+		}
+		snapshot.Comments = append(snapshot.Comments, created)
+	}
+	return snapshot
+}
+
+func (state *EngineActor) restoreSnapshot(context actor.Context, snapshot *proto.EngineSnapshot) {
+	for _, user := range snapshot.Users {
+		state.applyUserRegistered(context, user)
+	}
+	for _, subreddit := range snapshot.Subreddits {
+		state.applySubredditCreated(context, subreddit)
+	}
+	for _, post := range snapshot.Posts {
+		state.applyPostCreated(context, post)
+	}
+	for _, comment := range snapshot.Comments {
+		state.applyCommentCreated(context, comment)
+	}
+}
+
+func (state *EngineActor) handleReplayComplete(context actor.Context) {
+	if state.router == nil {
+		fmt.Printf("Engine recovered %d users and %d subreddits\n", len(state.users), len(state.subreddits))
+		return
+	}
+	fmt.Printf("Engine %s recovered %d users and %d subreddits\n", state.shard, len(state.users), len(state.subreddits))
+	context.Send(state.router, &shardReady{shard: state.shard})
+}
+
+// handleHandOffKeys gives the shard being added the entries it owns on the new ring. The
+// router holds requests for them until the new shard has them.
+func (state *EngineActor) handleHandOffKeys(context actor.Context, msg *handOffKeys) {
+	moving := func(key string) bool { return msg.ring.Owner(key) != state.shard }
+	handoff := &proto.ShardHandOff{
+		From:      state.shard,
+		Directory: state.directory(moving),
+	}
+	for _, session := range state.sessions {
+		if moving(userKey(session.Username)) {
+			handoff.Sessions = append(handoff.Sessions, &proto.Session{
+				Token:     session.Token,
+				Username:  session.Username,
+				ExpiresAt: session.ExpiresAt.Unix(),
+			})
+		}
+	}
+	for username, presence := range state.presence {
+		if moving(userKey(username)) {
+			handoff.Presence = append(handoff.Presence, presence)
+		}
+	}
+
+	event := &proto.KeysHandedOff{Shards: msg.ring.Nodes()}
+	state.PersistReceive(event)
+	state.applyKeysHandedOff(event)
+	context.Send(msg.to, handoff)
+
+	fmt.Printf("Engine %s handed %d users and %d subreddits to %s\n", state.shard,
+		len(handoff.Directory.Users), len(handoff.Directory.Subreddits), msg.to.Id)
+}
+
+// applyKeysHandedOff forgets the entries of other shards. The user and subreddit actors keep
+// running; they belong to the router.
+func (state *EngineActor) applyKeysHandedOff(event *proto.KeysHandedOff) {
+	ring := utils.NewHashRing(utils.DefaultRingReplicas, event.Shards...)
+	foreign := func(key string) bool { return ring.Owner(key) != state.shard }
+	for username := range state.users {
+		if foreign(userKey(username)) {
+			delete(state.users, username)
+			delete(state.presence, username)
+		}
+	}
+	for token, session := range state.sessions {
+		if foreign(userKey(session.Username)) {
+			delete(state.sessions, token)
+		}
+	}
+	for name := range state.subreddits {
+		if foreign(subredditKey(name)) {
+			delete(state.subreddits, name)
+		}
+	}
+	for postID, post := range state.posts {
+		if foreign(subredditKey(post.SubredditName)) {
+			delete(state.posts, postID)
+		}
+	}
+	for commentID := range state.comments {
+		if foreign(commentKey(commentID)) {
+			delete(state.comments, commentID)
+		}
+	}
+}
+
+// handleShardHandOff takes over the entries another shard handed off. Sessions and presence
+// are not journaled, as elsewhere in the engine.
+func (state *EngineActor) handleShardHandOff(context actor.Context, msg *proto.ShardHandOff) {
+	if !state.Recovering() {
+		state.PersistReceive(&proto.ShardHandOff{From: msg.From, Directory: msg.Directory})
+	}
+	state.restoreSnapshot(context, msg.Directory)
+	for _, session := range msg.Sessions {
+		state.sessions[session.Token] = &models.Session{
+			Token:     session.Token,
+			Username:  session.Username,
+			ExpiresAt: time.Unix(session.ExpiresAt, 0),
+		}
+	}
+	for _, presence := range msg.Presence {
+		state.presence[presence.Username] = presence
+	}
+
+	if !state.Recovering() {
+		context.Send(state.router, &handOffComplete{from: msg.From})
+	}
+}
+
+func (config engineConfig) compactJournals(context actor.Context) {
+	compactor, ok := config.journal.(journal.Compactor)
+	if !ok {
+		context.Respond(&proto.CompactJournalsResponse{
+			Success: false,
+			Message: "Journal does not support compaction",
+		})
+		return
+	}
+
+	actors, events, err := compactor.Compact()
+	if err != nil {
+		context.Respond(&proto.CompactJournalsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Compaction failed: %v", err),
+		})
+		return
+	}
+	fmt.Printf("Compacted %d events from the journals of %d actors\n", events, actors)
+	context.Respond(&proto.CompactJournalsResponse{
+		Success:         true,
+		Message:         "Journals compacted",
+		ActorsCompacted: int32(actors),
+		EventsDeleted:   int32(events),
+	})
 }
 
+// On a sharded engine the user's shard fills in the user's PID and routes the join again, to
+// the subreddit's shard
 func (state *EngineActor) handleJoinSubreddit(context actor.Context, msg *proto.JoinSubreddit) {
+	if msg.UserPid == nil {
+		user, userExists := state.users[msg.Username]
+		if !userExists {
+			fmt.Printf("Client %s does not exist\n", msg.Username)
+			return
+		}
+
+		userPidMessage := &proto.PID{
+			Address: user.PID.Address,
+			Id:      user.PID.Id,
+		}
+		msg.UserPid = userPidMessage
+		if state.router != nil {
+			context.Forward(state.router)
+			return
+		}
+	}
+
 	subreddit, exists := state.subreddits[msg.SubredditName]
 	if !exists {
 		log.WithFields(log.Fields{
@@ -116,18 +869,6 @@ func (state *EngineActor) handleJoinSubreddit(context actor.Context, msg *proto.
 		return
 	}
 
-	user, userExists := state.users[msg.Username]
-	if !userExists {
-		fmt.Printf("Client %s does not exist\n", msg.Username)
-		return
-	}
-
-	userPidMessage := &proto.PID{
-		Address: user.PID.Address,
-		Id:      user.PID.Id,
-	}
-	msg.UserPid = userPidMessage
-
 	subredditPidMessage := &proto.PID{
 		Address: subreddit.PID.Address,
 		Id:      subreddit.PID.Id,
@@ -144,7 +885,8 @@ func (state *EngineActor) handleLeaveSubreddit(context actor.Context, msg *proto
 		return
 	}
 
-	if _, userExists := state.users[msg.Username]; !userExists {
+	// A shard does not know users of other shards; the subreddit ignores non-members anyway
+	if _, userExists := state.users[msg.Username]; !userExists && state.router == nil {
 		fmt.Printf("Client %s does not exist\n", msg.Username)
 		return
 	}
@@ -156,10 +898,180 @@ func (state *EngineActor) handlePostToSubreddit(context actor.Context, msg *prot
 	subreddit, exists := state.subreddits[msg.SubredditName]
 	if !exists {
 		fmt.Printf("Subreddit %s does not exist\n", msg.SubredditName)
+		context.Respond(&proto.NotFound{Kind: "subreddit", Id: msg.SubredditName})
 		return
 	}
 
-	context.Send(subreddit.PID, msg)
+	// SubredditActor responds to the original sender with a PostResponse
+	context.Forward(subreddit.PID)
+}
+
+// PostCreated and CommentCreated are journaled as they are and replayed through these handlers
+func (state *EngineActor) handlePostCreated(context actor.Context, msg *proto.PostCreated) {
+	sender := context.Sender()
+	if !state.Recovering() {
+		state.PersistReceive(msg)
+	}
+	state.applyPostCreated(context, msg)
+	state.confirmRegistration(context, sender)
+}
+
+func (state *EngineActor) applyPostCreated(context actor.Context, msg *proto.PostCreated) {
+	post := &models.Post{
+		PostID:        msg.PostId,
+		SubredditName: msg.SubredditName,
+		Content:       msg.Content,
+		Author:        msg.Author,
+		PID:           state.env.pid(context, msg.PostPid),
+	}
+	state.posts[msg.PostId] = post
+	state.store(context, *post)
+}
+
+func (state *EngineActor) handleCommentCreated(context actor.Context, msg *proto.CommentCreated) {
+	sender := context.Sender()
+	if !state.Recovering() {
+		state.PersistReceive(msg)
+	}
+	state.applyCommentCreated(context, msg)
+	state.confirmRegistration(context, sender)
+}
+
+// confirmRegistration answers grains that wait for a post or comment to be routable, see
+// Env.registerWithEngine
+func (state *EngineActor) confirmRegistration(context actor.Context, sender *actor.PID) {
+	if sender != nil && !state.Recovering() {
+		context.Send(sender, &proto.Registered{})
+	}
+}
+
+func (state *EngineActor) applyCommentCreated(context actor.Context, msg *proto.CommentCreated) {
+	comment := &models.Comment{
+		CommentID: msg.CommentId,
+		PostID:    msg.PostId,
+		Content:   msg.Content,
+		Author:    msg.Author,
+		PID:       state.env.pid(context, msg.CommentPid),
+	}
+	if msg.PostPid != nil {
+		comment.PostPID = state.env.pid(context, msg.PostPid)
+	}
+	state.comments[msg.CommentId] = comment
+	state.store(context, *comment)
+}
+
+func (state *EngineActor) handleCommentOnPost(context actor.Context, msg *proto.CommentOnPost) {
+	post, exists := state.posts[msg.PostId]
+	if !exists {
+		fmt.Printf("Post %s does not exist\n", msg.PostId)
+		context.Respond(&proto.NotFound{Kind: "post", Id: msg.PostId})
+		return
+	}
+
+	context.Forward(post.PID)
+}
+
+func (state *EngineActor) handleVoteOnPost(context actor.Context, msg *proto.VoteOnPost) {
+	post, exists := state.posts[msg.PostId]
+	if !exists {
+		fmt.Printf("Post %s does not exist\n", msg.PostId)
+		context.Respond(&proto.NotFound{Kind: "post", Id: msg.PostId})
+		return
+	}
+
+	context.Forward(post.PID)
+}
+
+// Comment messages are routed through the PostActor that owns the comment
+func (state *EngineActor) handleCommentOnComment(context actor.Context, msg *proto.CommentOnComment) {
+	postPID, exists := state.postForComment(msg.ParentCommentId)
+	if !exists {
+		fmt.Printf("Comment %s does not exist\n", msg.ParentCommentId)
+		context.Respond(&proto.NotFound{Kind: "comment", Id: msg.ParentCommentId})
+		return
+	}
+
+	context.Forward(postPID)
+}
+
+func (state *EngineActor) handleVoteOnComment(context actor.Context, msg *proto.VoteOnComment) {
+	postPID, exists := state.postForComment(msg.CommentId)
+	if !exists {
+		fmt.Printf("Comment %s does not exist\n", msg.CommentId)
+		context.Respond(&proto.NotFound{Kind: "comment", Id: msg.CommentId})
+		return
+	}
+
+	context.Forward(postPID)
+}
+
+// Like joins, reposts on a sharded engine are routed to the target subreddit's shard first
+// and then to the original post's
+func (state *EngineActor) handleRepost(context actor.Context, msg *proto.Repost) {
+	if msg.SubredditPid == nil {
+		subreddit, exists := state.subreddits[msg.SubredditName]
+		if !exists {
+			fmt.Printf("Subreddit %s does not exist\n", msg.SubredditName)
+			context.Respond(&proto.NotFound{Kind: "subreddit", Id: msg.SubredditName})
+			return
+		}
+
+		msg.SubredditPid = &proto.PID{
+			Address: subreddit.PID.Address,
+			Id:      subreddit.PID.Id,
+		}
+		if state.router != nil {
+			context.Forward(state.router)
+			return
+		}
+	}
+
+	original, exists := state.posts[msg.OriginalPostId]
+	if !exists {
+		fmt.Printf("Post %s does not exist\n", msg.OriginalPostId)
+		context.Respond(&proto.NotFound{Kind: "post", Id: msg.OriginalPostId})
+		return
+	}
+
+	// The original PostActor counts the repost and hands the new post to the target subreddit
+	context.Forward(original.PID)
+}
+
+func (state *EngineActor) handleGetPostWithComments(context actor.Context, msg *proto.GetPostWithComments) {
+	post, exists := state.posts[msg.PostId]
+	if !exists {
+		context.Respond(&proto.NotFound{Kind: "post", Id: msg.PostId})
+		return
+	}
+
+	context.Forward(post.PID)
+}
+
+func (state *EngineActor) handleGetComment(context actor.Context, msg *proto.GetComment) {
+	postPID, exists := state.postForComment(msg.CommentId)
+	if !exists {
+		context.Respond(&proto.NotFound{Kind: "comment", Id: msg.CommentId})
+		return
+	}
+
+	context.Forward(postPID)
+}
+
+// postForComment returns the PID of the post a comment belongs to. Comments journaled without
+// the post's PID look the post up, which only works while it is on the same shard.
+func (state *EngineActor) postForComment(commentID string) (*actor.PID, bool) {
+	comment, exists := state.comments[commentID]
+	if !exists {
+		return nil, false
+	}
+	if comment.PostPID != nil {
+		return comment.PostPID, true
+	}
+	post, exists := state.posts[comment.PostID]
+	if !exists {
+		return nil, false
+	}
+	return post.PID, true
 }
 
 func (state *EngineActor) handleSendDirectMessage(context actor.Context, msg *proto.SendDirectMessage) {
@@ -169,6 +1081,12 @@ func (state *EngineActor) handleSendDirectMessage(context actor.Context, msg *pr
 		return
 	}
 
+	state.store(context, models.Message{
+		FromUsername: msg.FromUsername,
+		ToUsername:   msg.ToUsername,
+		Content:      msg.Content,
+		Timestamp:    state.env.Clock.Now().Unix(),
+	})
 	context.Send(recipient.PID, msg)
 }
 
@@ -181,15 +1099,7 @@ func (state *EngineActor) handleGetInbox(context actor.Context, msg *proto.GetIn
 		return
 	}
 
-	future := context.RequestFuture(user.PID, msg, 5*time.Second)
-	res, err := future.Result()
-	if err != nil {
-		fmt.Printf("Error getting inbox for user %s: %v\n", msg.Username, err)
-		inbox := &proto.Inbox{Messages: []*proto.DirectMessage{}}
-		context.Respond(inbox)
-		return
-	}
-	context.Respond(res)
+	context.Forward(user.PID)
 }
 
 func (state *EngineActor) handleGetFeed(context actor.Context, msg *proto.GetFeed) {
@@ -201,15 +1111,18 @@ func (state *EngineActor) handleGetFeed(context actor.Context, msg *proto.GetFee
 		return
 	}
 
-	future := context.RequestFuture(user.PID, msg, 5*time.Second)
-	res, err := future.Result()
-	if err != nil {
-		fmt.Printf("Error getting feed for user %s: %v\n", msg.Username, err)
-		feed := &proto.Feed{Posts: []*proto.Post{}}
-		context.Respond(feed)
+	// UserActor assembles the feed without blocking and answers the original sender
+	context.Forward(user.PID)
+}
+
+func (state *EngineActor) handleGetSubredditPosts(context actor.Context, msg *proto.GetSubredditPosts) {
+	subreddit, exists := state.subreddits[msg.SubredditName]
+	if !exists {
+		context.Respond(&proto.NotFound{Kind: "subreddit", Id: msg.SubredditName})
 		return
 	}
-	context.Respond(res)
+
+	context.Forward(subreddit.PID)
 }
 
 func (state *EngineActor) handleUpdateKarma(context actor.Context, msg *proto.UpdateKarma) {
@@ -219,12 +1132,81 @@ func (state *EngineActor) handleUpdateKarma(context actor.Context, msg *proto.Up
 	}
 }
 
-func (state *EngineActor) startMetricsLogger() {
-	go func() {
-		ticker := time.NewTicker(10 * time.Second)
-		for {
-			<-ticker.C
-			fmt.Printf("Total messages processed: %d\n", state.totalMessages)
-		}
-	}()
+func (state *EngineActor) handleGetUserProfile(context actor.Context, msg *proto.GetUserProfile) {
+	user, exists := state.users[msg.Username]
+	if !exists {
+		context.Respond(&proto.NotFound{Kind: "user", Id: msg.Username})
+		return
+	}
+
+	context.Forward(user.PID)
+}
+
+// Presence is not journaled: after a restart every user is offline until their client connects.
+// Nor are the notifications a UserActor holds for an offline user, which a restart drops.
+func (state *EngineActor) handleConnect(context actor.Context, msg *proto.Connect) {
+	user, exists := state.users[msg.Username]
+	if !exists {
+		context.Respond(&proto.NotFound{Kind: "user", Id: msg.Username})
+		return
+	}
+
+	state.setPresence(msg.Username, true)
+	context.Forward(user.PID)
+}
+
+func (state *EngineActor) handleDisconnect(context actor.Context, msg *proto.Disconnect) {
+	user, exists := state.users[msg.Username]
+	if !exists {
+		context.Respond(&proto.NotFound{Kind: "user", Id: msg.Username})
+		return
+	}
+
+	state.setPresence(msg.Username, false)
+	context.Forward(user.PID)
+}
+
+func (state *EngineActor) setPresence(username string, online bool) {
+	state.presence[username] = &proto.Presence{
+		Username: username,
+		Online:   online,
+		LastSeen: state.env.Clock.Now().Unix(),
+	}
+}
+
+func (state *EngineActor) handleGetPresence(context actor.Context, msg *proto.GetPresence) {
+	if _, exists := state.users[msg.Username]; !exists {
+		context.Respond(&proto.NotFound{Kind: "user", Id: msg.Username})
+		return
+	}
+
+	presence, known := state.presence[msg.Username]
+	if !known {
+		presence = &proto.Presence{Username: msg.Username}
+	}
+	context.Respond(presence)
+}
+
+// newSessionToken starts the token with the username, so a sharded engine can route it to the
+// shard keeping the session; see sessionUser
+func newSessionToken(username string) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString([]byte(username)) + "." + hex.EncodeToString(buf), nil
+}
+
+// updateGauges runs on the engine's goroutine, the only one allowed to read its maps. It adds
+// what changed since the last update, so the gauges sum up the shards of a sharded engine.
+func (state *EngineActor) updateGauges() {
+	var sizes [4]int
+	if !state.discarded {
+		sizes = [4]int{len(state.users), len(state.subreddits), len(state.posts), len(state.comments)}
+	}
+	gauges := []prometheus.Gauge{state.env.Metrics.users, state.env.Metrics.subreddits, state.env.Metrics.posts, state.env.Metrics.comments}
+	for i, gauge := range gauges {
+		gauge.Add(float64(sizes[i] - state.gauged[i]))
+	}
+	state.gauged = sizes
 }
@@ -0,0 +1,98 @@
+package actors
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/persistence"
+	"github.com/tejasriramparvathaneni/reddit_clone/utils"
+)
+
+// ActorKind names a type of persistent actor, so settings like the snapshot interval can
+// differ between them
+type ActorKind string
+
+const (
+	KindEngine    ActorKind = "engine"
+	KindUser      ActorKind = "user"
+	KindSubreddit ActorKind = "subreddit"
+	KindPost      ActorKind = "post"
+	KindComment   ActorKind = "comment"
+	KindRouter    ActorKind = "router"
+)
+
+var actorKinds = []ActorKind{KindEngine, KindUser, KindSubreddit, KindPost, KindComment, KindRouter}
+
+// Env holds the engine-wide settings handed down the actor hierarchy.
+//
+// PersistReceive may deliver a RequestSnapshot to the actor, which replaces the message being
+// handled, so handlers that persist read the sender before doing so.
+type Env struct {
+	EnginePID   *actor.PID
+	Journals    map[ActorKind]persistence.Provider
+	FanoutLimit int
+	Recovery    *RecoveryStats
+	Metrics     *Metrics
+	Supervision *Supervision
+	Clock       utils.Clock
+	// Set on cluster nodes, where users, subreddits and posts are grains
+	Cluster *cluster.Cluster
+	// Watches the activations behind this node's grain proxies, see grainProcess
+	grainWatcher *actor.PID
+}
+
+// props wraps producer so the actor recovers from and persists to the journal of its kind,
+// reports its messages and mailbox to the metrics, and is restarted by Supervision when it crashes
+func (env *Env) props(kind ActorKind, producer actor.Producer) *actor.Props {
+	return actor.PropsFromProducer(producer,
+		actor.WithReceiverMiddleware(
+			env.Supervision.track(kind),
+			env.Recovery.measure(kind),
+			env.Metrics.instrument(kind),
+			persistence.Using(env.Journals[kind]),
+		),
+		actor.WithMailbox(actor.Unbounded(env.Metrics.mailbox(kind))),
+		actor.WithSupervisor(env.Supervision),
+	)
+}
+
+// rootProps are props for actors spawned at the root, whose guardian restarts them the same way
+func (env *Env) rootProps(kind ActorKind, producer actor.Producer) *actor.Props {
+	return env.props(kind, producer).Configure(actor.WithGuardian(env.Supervision))
+}
+
+// ParseSnapshotIntervals reads intervals written as "post=100,comment=100"
+func ParseSnapshotIntervals(spec string) (map[ActorKind]int, error) {
+	intervals := make(map[ActorKind]int)
+	if spec == "" {
+		return intervals, nil
+	}
+	for _, part := range strings.Split(spec, ",") {
+		name, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			return nil, fmt.Errorf("expected kind=interval, got %q", part)
+		}
+		kind := ActorKind(name)
+		if !isActorKind(kind) {
+			return nil, fmt.Errorf("unknown actor kind %q", name)
+		}
+		interval, err := strconv.Atoi(value)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid snapshot interval %q for %s", value, name)
+		}
+		intervals[kind] = interval
+	}
+	return intervals, nil
+}
+
+func isActorKind(kind ActorKind) bool {
+	for _, known := range actorKinds {
+		if kind == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package actors
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+	"github.com/tejasriramparvathaneni/reddit_clone/utils"
+)
+
+// Feed sort modes accepted in GetFeed.Sort
+const (
+	SortHot           = "hot"
+	SortNew           = "new"
+	SortTop           = "top"
+	SortRising        = "rising"
+	SortControversial = "controversial"
+)
+
+// risingWindow is how recent a post must be to show up in the rising feed
+const risingWindow = 24 * time.Hour
+
+// Time windows accepted in GetFeed.TimeWindow for the top sort
+var timeWindows = map[string]time.Duration{
+	"hour": time.Hour,
+	"day":  24 * time.Hour,
+	"week": 7 * 24 * time.Hour,
+	"all":  0,
+}
+
+func IsValidFeedSort(mode string) bool {
+	switch mode {
+	case SortHot, SortNew, SortTop, SortRising, SortControversial:
+		return true
+	}
+	return false
+}
+
+func IsValidTimeWindow(window string) bool {
+	_, ok := timeWindows[window]
+	return ok
+}
+
+type rankedPost struct {
+	post  *proto.Post
+	score float64
+}
+
+// rankPosts orders posts for the given sort mode, dropping posts outside the mode's time
+// window. Unknown modes fall back to hot. Ties go to the newer post.
+func rankPosts(posts []*proto.Post, mode, window string, now time.Time) []rankedPost {
+	var score func(post *proto.Post) float64
+
+	switch mode {
+	case SortNew:
+		score = func(post *proto.Post) float64 { return float64(post.Timestamp) }
+	case SortTop:
+		posts = postsSince(posts, now, timeWindows[window])
+		score = func(post *proto.Post) float64 { return float64(post.Upvotes - post.Downvotes) }
+	case SortRising:
+		posts = postsSince(posts, now, risingWindow)
+		score = func(post *proto.Post) float64 {
+			return utils.RisingScore(post.Upvotes, post.Downvotes, post.Timestamp, now)
+		}
+	case SortControversial:
+		score = func(post *proto.Post) float64 { return utils.ControversyScore(post.Upvotes, post.Downvotes) }
+	default:
+		score = func(post *proto.Post) float64 { return utils.HotScore(post.Upvotes, post.Downvotes, post.Timestamp) }
+	}
+
+	ranked := make([]rankedPost, 0, len(posts))
+	for _, post := range posts {
+		ranked = append(ranked, rankedPost{post: post, score: score(post)})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].before(ranked[j].score, ranked[j].post.Timestamp, ranked[j].post.PostId)
+	})
+	return ranked
+}
+
+// before reports whether the post sorts ahead of the given sort key
+func (ranked rankedPost) before(score float64, timestamp int64, postID string) bool {
+	if ranked.score != score {
+		return ranked.score > score
+	}
+	if ranked.post.Timestamp != timestamp {
+		return ranked.post.Timestamp > timestamp
+	}
+	return ranked.post.PostId > postID
+}
+
+// pagePosts returns up to pageSize posts following the cursor and the cursor for the next
+// page, which is empty on the last page. A pageSize of 0 returns everything after the cursor.
+func pagePosts(ranked []rankedPost, encodedCursor string, pageSize int32) ([]*proto.Post, string) {
+	start := 0
+	if cursor, err := utils.DecodeCursor(encodedCursor); err == nil && cursor != nil {
+		start = sort.Search(len(ranked), func(i int) bool {
+			return !ranked[i].before(cursor.Score, cursor.Timestamp, cursor.ID)
+		})
+		if start < len(ranked) && ranked[start].post.PostId == cursor.ID {
+			start++
+		}
+	}
+
+	end := len(ranked)
+	if pageSize > 0 && start+int(pageSize) < end {
+		end = start + int(pageSize)
+	}
+
+	page := make([]*proto.Post, 0, end-start)
+	for _, entry := range ranked[start:end] {
+		page = append(page, entry.post)
+	}
+
+	nextCursor := ""
+	if end < len(ranked) {
+		nextCursor = ranked[end-1].cursor()
+	}
+	return page, nextCursor
+}
+
+// cursor resumes a listing after the post
+func (ranked rankedPost) cursor() string {
+	return utils.EncodeCursor(utils.Cursor{
+		Score:     ranked.score,
+		Timestamp: ranked.post.Timestamp,
+		ID:        ranked.post.PostId,
+	})
+}
+
+// postsSince keeps posts made within window of now; a zero window keeps everything
+func postsSince(posts []*proto.Post, now time.Time, window time.Duration) []*proto.Post {
+	if window == 0 {
+		return posts
+	}
+
+	cutoff := now.Add(-window).Unix()
+	var recent []*proto.Post
+	for _, post := range posts {
+		if post.Timestamp >= cutoff {
+			recent = append(recent, post)
+		}
+	}
+	return recent
+}
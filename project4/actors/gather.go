@@ -0,0 +1,42 @@
+package actors
+
+import (
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+)
+
+// gatherReplies sends msg to every target at once without blocking the actor. done runs
+// inside the actor once every target has replied or the shared deadline has passed, with
+// the replies keyed like targets and the keys of targets that did not reply in time.
+func gatherReplies(context actor.Context, targets map[string]*actor.PID, msg interface{}, deadline time.Duration, done func(replies map[string]interface{}, timedOut []string)) {
+	gatherEach(context, targets, func(string) interface{} { return msg }, deadline, done)
+}
+
+// gatherEach is gatherReplies with a message of its own for every target
+func gatherEach(context actor.Context, targets map[string]*actor.PID, message func(key string) interface{}, deadline time.Duration, done func(replies map[string]interface{}, timedOut []string)) {
+	replies := make(map[string]interface{}, len(targets))
+	var timedOut []string
+
+	pending := len(targets)
+	if pending == 0 {
+		done(replies, timedOut)
+		return
+	}
+
+	for key, pid := range targets {
+		future := context.RequestFuture(pid, message(key), deadline)
+		context.ReenterAfter(future, func(res interface{}, err error) {
+			if err != nil {
+				timedOut = append(timedOut, key)
+			} else {
+				replies[key] = res
+			}
+
+			pending--
+			if pending == 0 {
+				done(replies, timedOut)
+			}
+		})
+	}
+}
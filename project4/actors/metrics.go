@@ -0,0 +1,119 @@
+package actors
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics are the Prometheus collectors the actors report to
+type Metrics struct {
+	registerer prometheus.Registerer
+	messages   *prometheus.CounterVec
+	handling   *prometheus.HistogramVec
+	mailboxes  *prometheus.GaugeVec
+	crashes    *prometheus.CounterVec
+	users      prometheus.Gauge
+	subreddits prometheus.Gauge
+	posts      prometheus.Gauge
+	comments   prometheus.Gauge
+}
+
+// NewMetrics creates the collectors and registers them with registerer; pass
+// prometheus.DefaultRegisterer to serve them from promhttp.Handler
+func NewMetrics(registerer prometheus.Registerer) *Metrics {
+	metrics := &Metrics{
+		registerer: registerer,
+		messages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reddit_actor_messages_total",
+			Help: "Messages handled, by actor kind and message type.",
+		}, []string{"kind", "message"}),
+		handling: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "reddit_actor_handler_duration_seconds",
+			Help:    "Time spent handling a message, by actor kind and message type.",
+			Buckets: prometheus.ExponentialBuckets(0.00001, 4, 10),
+		}, []string{"kind", "message"}),
+		mailboxes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "reddit_actor_mailbox_depth",
+			Help: "Messages waiting in the mailboxes of all actors of a kind.",
+		}, []string{"kind"}),
+		crashes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reddit_actor_crashes_total",
+			Help: "Actors that crashed, by actor kind and whether they were restarted, escalated or stopped.",
+		}, []string{"kind", "directive"}),
+		users: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "reddit_users",
+			Help: "Registered users.",
+		}),
+		subreddits: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "reddit_subreddits",
+			Help: "Subreddits created.",
+		}),
+		posts: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "reddit_posts",
+			Help: "Posts, including reposts.",
+		}),
+		comments: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "reddit_comments",
+			Help: "Comments and replies.",
+		}),
+	}
+	registerer.MustRegister(metrics.messages, metrics.handling, metrics.mailboxes, metrics.crashes,
+		metrics.users, metrics.subreddits, metrics.posts, metrics.comments)
+	return metrics
+}
+
+// ServeMetrics serves /metrics from prometheus.DefaultRegisterer on addr in the background.
+// The /debug/pprof handlers are only served next to it with profiling.
+func ServeMetrics(addr string, profiling bool) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	if profiling {
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	}
+	go func() {
+		fmt.Println(http.ListenAndServe(addr, mux))
+	}()
+}
+
+// instrument counts and times every message the actor handles
+func (metrics *Metrics) instrument(kind ActorKind) actor.ReceiverMiddleware {
+	return func(next actor.ReceiverFunc) actor.ReceiverFunc {
+		return func(context actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+			message := messageType(envelope.Message)
+			start := time.Now()
+			next(context, envelope)
+			metrics.messages.WithLabelValues(string(kind), message).Inc()
+			metrics.handling.WithLabelValues(string(kind), message).Observe(time.Since(start).Seconds())
+		}
+	}
+}
+
+// messageType labels a message by its Go type, e.g. proto.PostToSubreddit
+func messageType(message interface{}) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", message), "*")
+}
+
+// mailbox tracks how many messages are queued for actors of kind
+func (metrics *Metrics) mailbox(kind ActorKind) actor.MailboxMiddleware {
+	return &mailboxDepth{gauge: metrics.mailboxes.WithLabelValues(string(kind))}
+}
+
+type mailboxDepth struct {
+	gauge prometheus.Gauge
+}
+
+func (depth *mailboxDepth) MailboxStarted()                     {}
+func (depth *mailboxDepth) MessagePosted(message interface{})   { depth.gauge.Inc() }
+func (depth *mailboxDepth) MessageReceived(message interface{}) { depth.gauge.Dec() }
+func (depth *mailboxDepth) MailboxEmpty()                       {}
@@ -0,0 +1,62 @@
+package actors
+
+import (
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/tejasriramparvathaneni/reddit_clone/utils"
+)
+
+// passwordTimeout bounds a bcrypt hash or check. They run on goroutines of their own, since
+// each takes long enough to hold up every message behind it on the engine.
+const passwordTimeout = 10 * time.Second
+
+type hashedPassword struct {
+	hash string
+	err  error
+}
+
+type checkedPassword struct {
+	ok bool
+}
+
+// hashPassword hashes password off the engine, then runs done on it with the hash
+func (state *EngineActor) hashPassword(context actor.Context, password string, done func(hash string, err error)) {
+	cost := state.config.passwordCost
+	state.offEngine(context, func() interface{} {
+		hash, err := utils.HashPassword(password, cost)
+		return &hashedPassword{hash: hash, err: err}
+	}, func(result interface{}, err error) {
+		if err != nil {
+			done("", err)
+			return
+		}
+		hashed := result.(*hashedPassword)
+		done(hashed.hash, hashed.err)
+	})
+}
+
+// checkPassword compares password with hash off the engine, then runs done on it with the verdict
+func (state *EngineActor) checkPassword(context actor.Context, hash, password string, done func(ok bool)) {
+	state.offEngine(context, func() interface{} {
+		return &checkedPassword{ok: utils.CheckPassword(hash, password)}
+	}, func(result interface{}, err error) {
+		done(err == nil && result.(*checkedPassword).ok)
+	})
+}
+
+// offEngine runs work on a goroutine of its own and done on the engine with its result. The
+// engine handles other messages meanwhile, so done must check that what it acts on is still
+// there; it is not run once this instance was discarded by a restart.
+func (state *EngineActor) offEngine(context actor.Context, work func() interface{}, done func(result interface{}, err error)) {
+	system := context.ActorSystem()
+	future := actor.NewFuture(system, passwordTimeout)
+	go func() {
+		system.Root.Send(future.PID(), work())
+	}()
+	context.ReenterAfter(future, func(result interface{}, err error) {
+		if !state.discarded {
+			done(result, err)
+		}
+	})
+}
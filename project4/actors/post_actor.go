@@ -2,103 +2,384 @@ package actors
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/persistence"
 	"github.com/tejasriramparvathaneni/reddit_clone/proto"
 )
 
 type PostActor struct {
-	PostID        string
-	Content       string
-	Author        string
-	SubredditName string
-	Timestamp     int64
-	Comments      map[string]*actor.PID
-	Upvotes       int32
-	Downvotes     int32
-	EnginePID     *actor.PID
-}
-
-func NewPostActor(postID, content, author, subredditName string, enginePID *actor.PID) actor.Actor {
+	persistence.Mixin
+	PostID                string
+	Content               string
+	Author                string
+	SubredditName         string
+	OriginalPostID        string
+	OriginalSubredditName string
+	RepostCount           int32
+	Timestamp             int64
+	Comments              map[string]*actor.PID
+	CommentIDs            []string
+	CommentIndex          map[string]*actor.PID
+	Upvotes               int32
+	Downvotes             int32
+	Votes                 map[string]int32
+	// Top-level comments as they were added, kept for snapshots
+	AddedComments []*proto.CommentAdded
+	env           *Env
+}
+
+// NewPostActor starts from the summary the SubredditActor keeps for the post
+func NewPostActor(post *proto.Post, env *Env) actor.Actor {
 	return &PostActor{
-		PostID:        postID,
-		Content:       content,
-		Author:        author,
-		SubredditName: subredditName,
-		Timestamp:     time.Now().Unix(),
-		Comments:      make(map[string]*actor.PID),
-		Upvotes:       0,
-		Downvotes:     0,
-		EnginePID:     enginePID,
+		PostID:                post.PostId,
+		Content:               post.Content,
+		Author:                post.Author,
+		SubredditName:         post.SubredditName,
+		OriginalPostID:        post.OriginalPostId,
+		OriginalSubredditName: post.OriginalSubredditName,
+		RepostCount:           0,
+		Timestamp:             post.Timestamp,
+		Comments:              make(map[string]*actor.PID),
+		CommentIndex:          make(map[string]*actor.PID),
+		Upvotes:               0,
+		Downvotes:             0,
+		Votes:                 make(map[string]int32),
+		env:                   env,
 	}
 }
 
 func (state *PostActor) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
+	case *actor.Started, *persistence.ReplayComplete:
+	case *actor.Restarting, *actor.Stopping, *actor.Stopped, *actor.Terminated:
+	case *cluster.ClusterInit:
+		// Grains are activated before they replay their journal
+		state.PostID = msg.Identity.Identity
+	case *persistence.RequestSnapshot:
+		state.PersistSnapshot(state.snapshot())
+	case *proto.PostSnapshot:
+		state.restoreSnapshot(context, msg)
+	case *proto.SubredditPostAdded:
+		state.handlePostAdded(msg)
+	case *proto.VoteCast:
+		state.applyVoteCast(msg)
+	case *proto.CommentAdded:
+		state.applyCommentAdded(context, msg)
+	case *proto.Reposted:
+		state.RepostCount++
 	case *proto.CommentOnPost:
 		state.handleCommentOnPost(context, msg)
 	case *proto.VoteOnPost:
 		state.handleVoteOnPost(context, msg)
 	case *proto.CommentOnComment:
 		state.forwardCommentOnComment(context, msg)
+	case *proto.VoteOnComment:
+		state.forwardVoteOnComment(context, msg)
+	case *proto.CommentCreated:
+		state.handleCommentCreated(context, msg)
+	case *proto.Repost:
+		state.handleRepost(context, msg)
 	case *proto.GetPostDetails:
 		state.handleGetPostDetails(context)
+	case *proto.GetPostWithComments:
+		state.handleGetPostWithComments(context, msg)
+	case *proto.GetComment:
+		state.forwardGetComment(context, msg)
 	default:
 		fmt.Printf("PostActor received a message: %T\n", msg)
 	}
 }
 
+func (state *PostActor) snapshot() *proto.PostSnapshot {
+	snapshot := &proto.PostSnapshot{
+		Comments:    append([]*proto.CommentAdded{}, state.AddedComments...),
+		Votes:       votesSnapshot(state.Votes),
+		RepostCount: state.RepostCount,
+		Post:        state.postDetails(),
+	}
+	for commentID, commentPID := range state.CommentIndex {
+		if _, topLevel := state.Comments[commentID]; !topLevel {
+			snapshot.Replies = append(snapshot.Replies, &proto.CommentCreated{
+				CommentId:  commentID,
+				PostId:     state.PostID,
+				CommentPid: &proto.PID{Address: commentPID.Address, Id: commentPID.Id},
+			})
+		}
+	}
+	return snapshot
+}
+
+func (state *PostActor) restoreSnapshot(context actor.Context, snapshot *proto.PostSnapshot) {
+	if snapshot.Post != nil {
+		state.applySummary(snapshot.Post)
+	}
+	for _, comment := range snapshot.Comments {
+		state.applyCommentAdded(context, comment)
+	}
+	for _, reply := range snapshot.Replies {
+		state.CommentIndex[reply.CommentId] = state.env.pid(context, reply.CommentPid)
+	}
+	for _, vote := range snapshot.Votes {
+		state.applyVoteCast(vote)
+	}
+	state.RepostCount = snapshot.RepostCount
+}
+
+// handlePostAdded gives a post grain its summary, which is journaled since nobody sends it
+// again when the grain is activated later
+func (state *PostActor) handlePostAdded(msg *proto.SubredditPostAdded) {
+	if state.Author != "" {
+		return
+	}
+	if !state.Recovering() {
+		state.PersistReceive(msg)
+	}
+	state.applySummary(msg.Post)
+}
+
+func (state *PostActor) applySummary(post *proto.Post) {
+	state.Content = post.Content
+	state.Author = post.Author
+	state.SubredditName = post.SubredditName
+	state.OriginalPostID = post.OriginalPostId
+	state.OriginalSubredditName = post.OriginalSubredditName
+	state.Timestamp = post.Timestamp
+}
+
 func (state *PostActor) handleGetPostDetails(context actor.Context) {
-	post := &proto.Post{
-		Content:       state.Content,
-		Author:        state.Author,
-		SubredditName: state.SubredditName,
-		Timestamp:     state.Timestamp,
-		Upvotes:       state.Upvotes,
-		Downvotes:     state.Downvotes,
-		PostId:        state.PostID,
+	context.Respond(state.postDetails())
+}
+
+func (state *PostActor) postDetails() *proto.Post {
+	return &proto.Post{
+		Content:               state.Content,
+		Author:                state.Author,
+		SubredditName:         state.SubredditName,
+		Timestamp:             state.Timestamp,
+		Upvotes:               state.Upvotes,
+		Downvotes:             state.Downvotes,
+		PostId:                state.PostID,
+		OriginalPostId:        state.OriginalPostID,
+		OriginalSubredditName: state.OriginalSubredditName,
+		RepostCount:           state.RepostCount,
+		CommentCount:          int32(len(state.CommentIndex)),
 	}
-	context.Respond(post)
 }
 
-func (state *PostActor) handleCommentOnPost(context actor.Context, msg *proto.CommentOnPost) {
-	commentID := fmt.Sprintf("%s_%d", state.PostID, len(state.Comments)+1)
+// pid is the PID other actors keep for the post, which in cluster mode is its grain proxy
+func (state *PostActor) pid(context actor.Context) *actor.PID {
+	if state.env.Cluster != nil {
+		return state.env.grainPID(KindPost, state.PostID)
+	}
+	return context.Self()
+}
+
+// subredditPID is the post's SubredditActor: its parent, or in cluster mode a grain. Votes can
+// reach a new grain before its summary does, so the subreddit is read from the post ID.
+func (state *PostActor) subredditPID(context actor.Context) *actor.PID {
+	if state.env.Cluster != nil {
+		return state.env.grainPID(KindSubreddit, postSubreddit(state.PostID))
+	}
+	return context.Parent()
+}
+
+// notifyScoreChanged keeps the SubredditActor's cached summary of this post current
+func (state *PostActor) notifyScoreChanged(context actor.Context) {
+	context.Send(state.subredditPID(context), &proto.PostScoreChanged{
+		PostId:       state.PostID,
+		Upvotes:      state.Upvotes,
+		Downvotes:    state.Downvotes,
+		CommentCount: int32(len(state.CommentIndex)),
+		RepostCount:  state.RepostCount,
+	})
+}
+
+func (state *PostActor) handleGetPostWithComments(context actor.Context, msg *proto.GetPostWithComments) {
+	sender := context.Sender()
+	page, more := pageCommentIDs(state.CommentIDs, msg.After, msg.Limit)
+	treeRequest := &proto.GetCommentTree{
+		MaxDepth:   msg.MaxDepth,
+		Limit:      msg.Limit,
+		DeadlineMs: commentTreeDeadline.Milliseconds(),
+	}
+
+	collectCommentTrees(context, state.Comments, page, treeRequest, func(comments []*proto.CommentNode, timedOut []string) {
+		context.Send(sender, &proto.PostWithComments{
+			Post:             state.postDetails(),
+			Comments:         comments,
+			MoreComments:     more,
+			TimedOutComments: timedOut,
+		})
+	})
+}
+
+func (state *PostActor) forwardGetComment(context actor.Context, msg *proto.GetComment) {
+	commentPID, exists := state.CommentIndex[msg.CommentId]
+	if !exists {
+		context.Respond(&proto.NotFound{Kind: "comment", Id: msg.CommentId})
+		return
+	}
 
-	commentProps := actor.PropsFromProducer(func() actor.Actor {
-		return NewCommentActor(msg.Content, msg.Author, commentID)
+	treeRequest := &proto.GetCommentTree{
+		MaxDepth: msg.MaxDepth,
+		Limit:    msg.Limit,
+		After:    msg.After,
+	}
+	context.RequestWithCustomSender(commentPID, treeRequest, context.Sender())
+}
+
+func (state *PostActor) handleRepost(context actor.Context, msg *proto.Repost) {
+	sender := context.Sender()
+	state.PersistReceive(&proto.Reposted{
+		Author:        msg.Author,
+		SubredditName: msg.SubredditName,
 	})
-	commentPID := context.Spawn(commentProps)
-	state.Comments[commentID] = commentPID
+	state.RepostCount++
+	state.notifyScoreChanged(context)
+
+	content := msg.Content
+	if content == "" {
+		content = state.Content
+	}
+
+	crosspost := &proto.PostToSubreddit{
+		Content:               content,
+		Author:                msg.Author,
+		SubredditName:         msg.SubredditName,
+		OriginalPostId:        state.PostID,
+		OriginalSubredditName: state.SubredditName,
+	}
+	subredditPID := state.env.pid(context, msg.SubredditPid)
+
+	// The target SubredditActor answers the original requester with a PostResponse
+	context.RequestWithCustomSender(subredditPID, crosspost, sender)
+
+	fmt.Printf("Client %s reposted post %s to subreddit %s\n", msg.Author, state.PostID, msg.SubredditName)
+}
+
+func (state *PostActor) handleCommentOnPost(context actor.Context, msg *proto.CommentOnPost) {
+	sender := context.Sender()
+	event := &proto.CommentAdded{
+		CommentId: fmt.Sprintf("%s_%d", state.PostID, len(state.Comments)+1),
+		PostId:    state.PostID,
+		Author:    msg.Author,
+		Content:   msg.Content,
+		Timestamp: state.env.Clock.Now().Unix(),
+	}
+	state.PersistReceive(event)
+	commentPID := state.applyCommentAdded(context, event)
+	if commentPID == nil {
+		context.Send(sender, &proto.CommentResponse{
+			Success: false,
+			Message: "Failed to create comment",
+		})
+		return
+	}
+	state.notifyScoreChanged(context)
 
 	fmt.Printf("Client %s commented on post %s\n", msg.Author, state.PostID)
+
+	postPID := state.pid(context)
+	registration := &proto.CommentCreated{
+		CommentId:  event.CommentId,
+		PostId:     state.PostID,
+		Author:     msg.Author,
+		CommentPid: &proto.PID{Address: commentPID.Address, Id: commentPID.Id},
+		Content:    msg.Content,
+		PostPid:    &proto.PID{Address: postPID.Address, Id: postPID.Id},
+	}
+	state.env.registerWithEngine(context, registration, func() {
+		context.Send(sender, &proto.CommentResponse{
+			Success:   true,
+			Message:   "Comment created",
+			CommentId: event.CommentId,
+		})
+	})
+}
+
+// applyCommentAdded spawns the CommentActor, which recovers its votes and replies from the journal
+func (state *PostActor) applyCommentAdded(context actor.Context, event *proto.CommentAdded) *actor.PID {
+	commentProps := state.env.props(KindComment, func() actor.Actor {
+		return NewCommentActor(event, state.pid(context), state.env)
+	})
+	commentPID, err := context.SpawnNamed(commentProps, event.CommentId)
+	if err != nil {
+		fmt.Printf("Failed to spawn comment actor for %s: %v\n", event.CommentId, err)
+		return nil
+	}
+	state.Comments[event.CommentId] = commentPID
+	state.CommentIDs = append(state.CommentIDs, event.CommentId)
+	state.AddedComments = append(state.AddedComments, event)
+	state.CommentIndex[event.CommentId] = commentPID
+	return commentPID
+}
+
+// Replies at any depth report themselves here, so CommentIndex covers the whole comment tree
+func (state *PostActor) handleCommentCreated(context actor.Context, msg *proto.CommentCreated) {
+	if !state.Recovering() {
+		state.PersistReceive(msg)
+	}
+	state.CommentIndex[msg.CommentId] = state.env.pid(context, msg.CommentPid)
+	if !state.Recovering() {
+		state.notifyScoreChanged(context)
+	}
 }
 
 func (state *PostActor) forwardCommentOnComment(context actor.Context, msg *proto.CommentOnComment) {
-	parentCommentPID, exists := state.Comments[msg.ParentCommentId]
+	parentCommentPID, exists := state.CommentIndex[msg.ParentCommentId]
 	if !exists {
 		fmt.Printf("Parent comment %s not found for reply by %s\n", msg.ParentCommentId, msg.Author)
+		context.Respond(&proto.NotFound{Kind: "comment", Id: msg.ParentCommentId})
 		return
 	}
 
-	context.Send(parentCommentPID, msg)
+	context.Forward(parentCommentPID)
+}
+
+func (state *PostActor) forwardVoteOnComment(context actor.Context, msg *proto.VoteOnComment) {
+	commentPID, exists := state.CommentIndex[msg.CommentId]
+	if !exists {
+		fmt.Printf("Comment %s not found for vote by %s\n", msg.CommentId, msg.Voter)
+		context.Respond(&proto.NotFound{Kind: "comment", Id: msg.CommentId})
+		return
+	}
+
+	context.Forward(commentPID)
 }
 
 func (state *PostActor) handleVoteOnPost(context actor.Context, msg *proto.VoteOnPost) {
-	if msg.Upvote {
-		state.Upvotes++
-		state.notifyAuthorKarma(context, 1)
-	} else {
-		state.Downvotes++
-		state.notifyAuthorKarma(context, -1)
+	sender := context.Sender()
+	event := &proto.VoteCast{
+		Voter: msg.Voter,
+		Value: voteValue(msg.Upvote, msg.Retract),
+	}
+	// Repeating a vote, or retracting one never cast, changes nothing and is not journaled
+	if state.Votes[msg.Voter] != event.Value {
+		state.PersistReceive(event)
+		state.notifyAuthorKarma(context, state.applyVoteCast(event))
+		state.notifyScoreChanged(context)
 	}
 	fmt.Printf("Client %s voted on post %s by %s\n", msg.Voter, state.PostID, state.Author)
+
+	context.Send(sender, &proto.VoteResponse{
+		Success:   true,
+		Message:   "Vote recorded",
+		Upvotes:   state.Upvotes,
+		Downvotes: state.Downvotes,
+	})
+}
+
+func (state *PostActor) applyVoteCast(event *proto.VoteCast) int32 {
+	return applyVote(state.Votes, &state.Upvotes, &state.Downvotes, event.Voter, event.Value)
 }
 
 func (state *PostActor) notifyAuthorKarma(context actor.Context, amount int32) {
 	updateKarmaMsg := &proto.UpdateKarma{
 		Username: state.Author,
 		Amount:   amount,
+		Kind:     proto.KarmaKind_POST_KARMA,
 	}
-	context.Send(state.EnginePID, updateKarmaMsg)
+	context.Send(state.env.EnginePID, updateKarmaMsg)
 }
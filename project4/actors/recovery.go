@@ -0,0 +1,99 @@
+package actors
+
+import (
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+)
+
+// RecoveryStats records how long actors took to rebuild their state from the journal
+// when they started
+type RecoveryStats struct {
+	mu       sync.Mutex
+	started  time.Time
+	finished time.Time
+	kinds    map[ActorKind]*proto.ActorRecovery
+}
+
+func NewRecoveryStats() *RecoveryStats {
+	return &RecoveryStats{
+		started: time.Now(),
+		kinds:   make(map[ActorKind]*proto.ActorRecovery),
+	}
+}
+
+// measure times the snapshot load and event replay that persistence.Using runs while the
+// actor handles Started
+func (stats *RecoveryStats) measure(kind ActorKind) actor.ReceiverMiddleware {
+	return func(next actor.ReceiverFunc) actor.ReceiverFunc {
+		return func(context actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+			if _, ok := envelope.Message.(*actor.Started); !ok {
+				next(context, envelope)
+				return
+			}
+			start := time.Now()
+			next(context, envelope)
+			stats.observe(kind, time.Since(start))
+		}
+	}
+}
+
+func (stats *RecoveryStats) observe(kind ActorKind, elapsed time.Duration) {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	recovery, exists := stats.kinds[kind]
+	if !exists {
+		recovery = &proto.ActorRecovery{Kind: string(kind)}
+		stats.kinds[kind] = recovery
+	}
+	recovery.Count++
+	recovery.TotalMicros += elapsed.Microseconds()
+	recovery.MaxMicros = max(recovery.MaxMicros, elapsed.Microseconds())
+	stats.finished = time.Now()
+}
+
+func (stats *RecoveryStats) Snapshot() *proto.RecoveryStats {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	snapshot := &proto.RecoveryStats{}
+	for _, kind := range actorKinds {
+		if recovery, exists := stats.kinds[kind]; exists {
+			snapshot.Actors = append(snapshot.Actors, &proto.ActorRecovery{
+				Kind:        recovery.Kind,
+				Count:       recovery.Count,
+				TotalMicros: recovery.TotalMicros,
+				MaxMicros:   recovery.MaxMicros,
+			})
+		}
+	}
+	if !stats.finished.IsZero() {
+		snapshot.TotalMicros = stats.finished.Sub(stats.started).Microseconds()
+	}
+	return snapshot
+}
+
+var (
+	recoveredDesc = prometheus.NewDesc("reddit_actors_recovered_total",
+		"Actors rebuilt from the journal since the engine started, by actor kind.", []string{"kind"}, nil)
+	recoveryDesc = prometheus.NewDesc("reddit_actor_recovery_seconds_total",
+		"Time spent rebuilding actors from the journal, by actor kind.", []string{"kind"}, nil)
+)
+
+// Describe and Collect export the stats to Prometheus
+func (stats *RecoveryStats) Describe(descs chan<- *prometheus.Desc) {
+	descs <- recoveredDesc
+	descs <- recoveryDesc
+}
+
+func (stats *RecoveryStats) Collect(metrics chan<- prometheus.Metric) {
+	for _, recovery := range stats.Snapshot().Actors {
+		metrics <- prometheus.MustNewConstMetric(recoveredDesc, prometheus.CounterValue, float64(recovery.Count), recovery.Kind)
+		metrics <- prometheus.MustNewConstMetric(recoveryDesc, prometheus.CounterValue,
+			(time.Duration(recovery.TotalMicros) * time.Microsecond).Seconds(), recovery.Kind)
+	}
+}
@@ -0,0 +1,364 @@
+package actors
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/persistence"
+	log "github.com/sirupsen/logrus"
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+	"github.com/tejasriramparvathaneni/reddit_clone/utils"
+)
+
+// ShardRouter stands in for the EngineActor when the engine is sharded. Each shard is an
+// EngineActor owning the users and subreddits whose names hash to it on the ring, along with
+// the subreddits' posts; comments are placed by their own ID. The router forwards every
+// request to the shard owning its key. User and subreddit actors are spawned next to the
+// router rather than below a shard, so they keep their PIDs when their entries move.
+//
+// Adding a shard rebalances without stopping: the existing shards hand the new one the
+// entries it takes over, and only requests for those entries are held until it has them.
+type ShardRouter struct {
+	persistence.Mixin
+	ring *utils.HashRing
+	// Ring with the shard being added, until every other shard handed off to it
+	next    *utils.HashRing
+	shards  map[string]*actor.PID
+	ready   map[string]bool
+	handing map[string]bool
+	held    []heldMessage
+	adders  []*actor.PID
+	config  engineConfig
+	env     *Env
+}
+
+type heldMessage struct {
+	message interface{}
+	sender  *actor.PID
+}
+
+// shardReady tells the router a shard finished recovering
+type shardReady struct {
+	shard string
+}
+
+// handOffKeys asks a shard to hand the entries it no longer owns on ring to the new shard
+type handOffKeys struct {
+	ring *utils.HashRing
+	to   *actor.PID
+}
+
+// handOffComplete tells the router the new shard has the entries of shard from
+type handOffComplete struct {
+	from string
+}
+
+func newShardRouter(config engineConfig, env *Env) *ShardRouter {
+	return &ShardRouter{
+		ring:    utils.NewHashRing(utils.DefaultRingReplicas),
+		shards:  make(map[string]*actor.PID),
+		ready:   make(map[string]bool),
+		handing: make(map[string]bool),
+		config:  config,
+		env:     env,
+	}
+}
+
+func (state *ShardRouter) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		state.env.EnginePID = context.Self()
+	case *persistence.RequestSnapshot:
+		state.PersistSnapshot(state.snapshot())
+	case *proto.ShardRouterSnapshot:
+		state.restoreSnapshot(context, msg)
+	case *persistence.ReplayComplete:
+		state.handleReplayComplete(context)
+	case *proto.ShardsCreated:
+		state.applyShardsCreated(context, msg)
+	case *proto.ShardAdded:
+		state.applyShardAdded(context, msg)
+	case *proto.ShardsRebalanced:
+		state.applyShardsRebalanced()
+	case *shardReady:
+		state.ready[msg.shard] = true
+		state.release(context)
+	case *handOffComplete:
+		state.handleHandOffComplete(context, msg)
+	case *proto.AddEngineShard:
+		state.handleAddEngineShard(context)
+	case *proto.CompactJournals:
+		state.config.compactJournals(context)
+	case *proto.GetRecoveryStats:
+		context.Respond(state.env.Recovery.Snapshot())
+	case actor.SystemMessage, actor.AutoReceiveMessage, actor.AutoRespond:
+	default:
+		state.route(context, msg, context.Sender())
+	}
+}
+
+func (state *ShardRouter) handleReplayComplete(context actor.Context) {
+	if len(state.shards) == 0 {
+		names := make([]string, max(state.config.shards, 1))
+		for i := range names {
+			names[i] = shardName(i)
+		}
+		event := &proto.ShardsCreated{Names: names}
+		state.PersistReceive(event)
+		state.applyShardsCreated(context, event)
+	}
+	fmt.Printf("Engine is sharded over %s\n", strings.Join(state.ring.Nodes(), ", "))
+
+	// A rebalance interrupted by a restart starts over; shards that already handed off
+	// have nothing left to hand
+	if state.next != nil {
+		state.rebalance(context)
+		return
+	}
+	state.grow(context)
+}
+
+func shardName(i int) string {
+	return fmt.Sprintf("shard-%d", i)
+}
+
+func (state *ShardRouter) applyShardsCreated(context actor.Context, event *proto.ShardsCreated) {
+	for _, name := range event.Names {
+		state.spawnShard(context, name)
+	}
+	state.ring = utils.NewHashRing(utils.DefaultRingReplicas, event.Names...)
+}
+
+func (state *ShardRouter) applyShardAdded(context actor.Context, event *proto.ShardAdded) {
+	state.spawnShard(context, event.Name)
+	state.next = state.ring.With(event.Name)
+}
+
+func (state *ShardRouter) applyShardsRebalanced() {
+	state.ring = state.next
+	state.next = nil
+}
+
+func (state *ShardRouter) spawnShard(context actor.Context, name string) {
+	router := context.Self()
+	props := state.env.props(KindEngine, func() actor.Actor {
+		return newEngineShard(state.config, state.env, name, router)
+	})
+	pid, err := context.SpawnNamed(props, name)
+	if err != nil {
+		fmt.Printf("Failed to spawn engine %s: %v\n", name, err)
+		return
+	}
+	state.shards[name] = pid
+}
+
+func (state *ShardRouter) snapshot() *proto.ShardRouterSnapshot {
+	snapshot := &proto.ShardRouterSnapshot{Shards: state.ring.Nodes()}
+	if state.next != nil {
+		nodes := state.next.Nodes()
+		snapshot.Adding = nodes[len(nodes)-1]
+	}
+	return snapshot
+}
+
+func (state *ShardRouter) restoreSnapshot(context actor.Context, snapshot *proto.ShardRouterSnapshot) {
+	state.applyShardsCreated(context, &proto.ShardsCreated{Names: snapshot.Shards})
+	if snapshot.Adding != "" {
+		state.applyShardAdded(context, &proto.ShardAdded{Name: snapshot.Adding})
+	}
+}
+
+// grow adds shards until there are as many as configured, one rebalance at a time
+func (state *ShardRouter) grow(context actor.Context) {
+	if state.next == nil && len(state.shards) < state.config.shards {
+		state.addShard(context)
+	}
+}
+
+func (state *ShardRouter) handleAddEngineShard(context actor.Context) {
+	if state.next != nil {
+		context.Respond(&proto.AddEngineShardResponse{
+			Success: false,
+			Message: "Another shard is still being added",
+			Shards:  int32(len(state.ring.Nodes())),
+		})
+		return
+	}
+	state.adders = append(state.adders, context.Sender())
+	state.addShard(context)
+}
+
+func (state *ShardRouter) addShard(context actor.Context) {
+	event := &proto.ShardAdded{Name: shardName(len(state.shards))}
+	state.PersistReceive(event)
+	state.applyShardAdded(context, event)
+	fmt.Printf("Adding engine %s\n", event.Name)
+	state.rebalance(context)
+}
+
+// rebalance has every existing shard hand off the entries the new shard takes over
+func (state *ShardRouter) rebalance(context actor.Context) {
+	nodes := state.next.Nodes()
+	to := state.shards[nodes[len(nodes)-1]]
+	for _, shard := range state.ring.Nodes() {
+		state.handing[shard] = true
+		context.Send(state.shards[shard], &handOffKeys{ring: state.next, to: to})
+	}
+}
+
+func (state *ShardRouter) handleHandOffComplete(context actor.Context, msg *handOffComplete) {
+	delete(state.handing, msg.from)
+	if len(state.handing) > 0 || state.next == nil {
+		return
+	}
+
+	event := &proto.ShardsRebalanced{}
+	state.PersistReceive(event)
+	state.applyShardsRebalanced()
+	fmt.Printf("Engine is rebalanced over %s\n", strings.Join(state.ring.Nodes(), ", "))
+
+	for _, adder := range state.adders {
+		context.Send(adder, &proto.AddEngineShardResponse{
+			Success: true,
+			Message: "Shard added",
+			Shards:  int32(len(state.ring.Nodes())),
+		})
+	}
+	state.adders = nil
+	state.release(context)
+	state.grow(context)
+}
+
+// route forwards message to the shard owning its key. Until every shard recovered, and while
+// its key moves to a new shard, the message is held instead.
+func (state *ShardRouter) route(context actor.Context, message interface{}, sender *actor.PID) {
+	key, ok := directoryKey(message)
+	if !ok {
+		log.WithFields(log.Fields{
+			"actor":   "ShardRouter",
+			"message": fmt.Sprintf("%T", message),
+		}).Warn("Received a message")
+		return
+	}
+
+	owner := state.ring.Owner(key)
+	if len(state.ready) < len(state.shards) || (state.next != nil && state.next.Owner(key) != owner) {
+		state.held = append(state.held, heldMessage{message: message, sender: sender})
+		return
+	}
+	context.RequestWithCustomSender(state.shards[owner], message, sender)
+}
+
+// release routes the held messages again, in the order they arrived
+func (state *ShardRouter) release(context actor.Context) {
+	held := state.held
+	state.held = nil
+	for _, message := range held {
+		state.route(context, message.message, message.sender)
+	}
+}
+
+// directoryKey is what a request is sharded by: the user or subreddit it is about, or the
+// comment. Posts belong to their subreddit, whose name their ID starts with. Requests about
+// both a user and a subreddit are routed twice, see EngineActor.handleJoinSubreddit.
+func directoryKey(message interface{}) (string, bool) {
+	switch msg := message.(type) {
+	case *proto.RegisterUser:
+		return userKey(msg.Username), true
+	case *proto.AuthenticateUser:
+		return userKey(msg.Username), true
+	case *proto.ChangePassword:
+		return userKey(msg.Username), true
+	case *proto.ValidateSession:
+		return userKey(sessionUser(msg.Token)), true
+	case *proto.Logout:
+		return userKey(sessionUser(msg.Token)), true
+	case *proto.SendDirectMessage:
+		return userKey(msg.ToUsername), true
+	case *proto.GetInbox:
+		return userKey(msg.Username), true
+	case *proto.GetFeed:
+		return userKey(msg.Username), true
+	case *proto.UpdateKarma:
+		return userKey(msg.Username), true
+	case *proto.GetUserProfile:
+		return userKey(msg.Username), true
+	case *proto.Connect:
+		return userKey(msg.Username), true
+	case *proto.Disconnect:
+		return userKey(msg.Username), true
+	case *proto.GetPresence:
+		return userKey(msg.Username), true
+	case *proto.JoinSubreddit:
+		if msg.UserPid == nil {
+			return userKey(msg.Username), true
+		}
+		return subredditKey(msg.SubredditName), true
+	case *proto.CreateSubreddit:
+		return subredditKey(msg.Name), true
+	case *proto.LeaveSubreddit:
+		return subredditKey(msg.SubredditName), true
+	case *proto.GetSubredditPosts:
+		return subredditKey(msg.SubredditName), true
+	case *proto.PostToSubreddit:
+		return subredditKey(msg.SubredditName), true
+	case *proto.PostCreated:
+		return subredditKey(msg.SubredditName), true
+	case *proto.Repost:
+		if msg.SubredditPid == nil {
+			return subredditKey(msg.SubredditName), true
+		}
+		return subredditKey(postSubreddit(msg.OriginalPostId)), true
+	case *proto.CommentOnPost:
+		return subredditKey(postSubreddit(msg.PostId)), true
+	case *proto.VoteOnPost:
+		return subredditKey(postSubreddit(msg.PostId)), true
+	case *proto.GetPostWithComments:
+		return subredditKey(postSubreddit(msg.PostId)), true
+	case *proto.CommentCreated:
+		return commentKey(msg.CommentId), true
+	case *proto.CommentOnComment:
+		return commentKey(msg.ParentCommentId), true
+	case *proto.VoteOnComment:
+		return commentKey(msg.CommentId), true
+	case *proto.GetComment:
+		return commentKey(msg.CommentId), true
+	}
+	return "", false
+}
+
+func userKey(username string) string {
+	return "user/" + username
+}
+
+func subredditKey(name string) string {
+	return "subreddit/" + name
+}
+
+func commentKey(commentID string) string {
+	return "comment/" + commentID
+}
+
+// postSubreddit reads the subreddit from a post ID, which SubredditActor numbers as name_n
+func postSubreddit(postID string) string {
+	if cut := strings.LastIndex(postID, "_"); cut > 0 {
+		return postID[:cut]
+	}
+	return postID
+}
+
+// sessionUser reads the username from a token made by newSessionToken, or returns "" when the
+// token is malformed
+func sessionUser(token string) string {
+	encoded, _, found := strings.Cut(token, ".")
+	if !found {
+		return ""
+	}
+	username, err := hex.DecodeString(encoded)
+	if err != nil {
+		return ""
+	}
+	return string(username)
+}
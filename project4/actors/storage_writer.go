@@ -0,0 +1,39 @@
+package actors
+
+import (
+	"fmt"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/tejasriramparvathaneni/reddit_clone/models"
+	"github.com/tejasriramparvathaneni/reddit_clone/storage"
+)
+
+// StorageWriter saves the entities the engine sends it to the repository, in the order they
+// were sent, so the engine never waits on the disk. Entities are sent as values, leaving the
+// engine free to change its own copies.
+type StorageWriter struct {
+	repository storage.Repository
+}
+
+func NewStorageWriter(repository storage.Repository) actor.Actor {
+	return &StorageWriter{repository: repository}
+}
+
+func (state *StorageWriter) Receive(context actor.Context) {
+	var err error
+	switch msg := context.Message().(type) {
+	case models.User:
+		err = state.repository.SaveUser(&msg)
+	case models.Subreddit:
+		err = state.repository.SaveSubreddit(&msg)
+	case models.Post:
+		err = state.repository.SavePost(&msg)
+	case models.Comment:
+		err = state.repository.SaveComment(&msg)
+	case models.Message:
+		err = state.repository.SaveMessage(&msg)
+	}
+	if err != nil {
+		fmt.Printf("Failed to store %T: %v\n", context.Message(), err)
+	}
+}
@@ -2,30 +2,65 @@ package actors
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/persistence"
 	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
+// DefaultFanoutLimit is the member count above which new posts are no longer pushed to
+// member timelines and feeds read the subreddit directly instead
+const DefaultFanoutLimit = 1000
+
+// scoresTimeout bounds how long a recovered subreddit waits for a post's scores
+const scoresTimeout = 5 * time.Second
+
+// Summaries holds a denormalized copy of each post kept current by PostScoreChanged, so
+// listings need no round-trips to PostActors. Entries are replaced, never modified, once
+// they have been handed out. Scores are not journaled; they are asked of the PostActors
+// again after recovery.
 type SubredditActor struct {
-	Name      string
-	Members   map[string]*actor.PID
-	Posts     map[string]*actor.PID
-	EnginePID *actor.PID
+	persistence.Mixin
+	SubredditName string
+	Members       map[string]*actor.PID
+	Posts         map[string]*actor.PID
+	Summaries     map[string]*proto.Post
+	FanoutOnRead  bool
+	env           *Env
+	// Posts yet to report their scores after recovery, and the listings waiting on them
+	scoresPending int
+	waiting       []func()
 }
 
-func NewSubredditActor(name string, enginePID *actor.PID) actor.Actor {
+func NewSubredditActor(name string, env *Env) actor.Actor {
 	return &SubredditActor{
-		Name:      name,
-		Members:   make(map[string]*actor.PID),
-		Posts:     make(map[string]*actor.PID),
-		EnginePID: enginePID,
+		SubredditName: name,
+		Members:       make(map[string]*actor.PID),
+		Posts:         make(map[string]*actor.PID),
+		Summaries:     make(map[string]*proto.Post),
+		env:           env,
 	}
 }
 
 func (state *SubredditActor) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
+	case *actor.Started:
+	case *persistence.ReplayComplete:
+		state.handleReplayComplete(context)
+	case *actor.Restarting, *actor.Stopping, *actor.Stopped, *actor.Terminated:
+	case *cluster.ClusterInit:
+		// Grains are activated before they replay their journal
+		state.SubredditName = msg.Identity.Identity
+	case *persistence.RequestSnapshot:
+		state.PersistSnapshot(state.snapshot())
+	case *proto.SubredditSnapshot:
+		state.restoreSnapshot(context, msg)
+	case *proto.SubredditPostAdded:
+		state.applyPostAdded(context, msg)
 	case *proto.JoinSubreddit:
 		state.handleJoinSubreddit(context, msg)
 	case *proto.LeaveSubreddit:
@@ -33,63 +68,250 @@ func (state *SubredditActor) Receive(context actor.Context) {
 	case *proto.PostToSubreddit:
 		state.handlePostToSubreddit(context, msg)
 	case *proto.GetSubredditPosts:
-		state.handleGetSubredditPosts(context)
+		state.handleGetSubredditPosts(context, msg)
+	case *proto.PostScoreChanged:
+		state.handlePostScoreChanged(msg)
 	default:
 		fmt.Printf("SubredditActor received unknown message: %T\n", msg)
 	}
 }
 
+func (state *SubredditActor) snapshot() *proto.SubredditSnapshot {
+	snapshot := &proto.SubredditSnapshot{FanoutOnRead: state.FanoutOnRead}
+	for username, userPID := range state.Members {
+		snapshot.Members = append(snapshot.Members, &proto.JoinSubreddit{
+			Username:      username,
+			SubredditName: state.SubredditName,
+			UserPid:       &proto.PID{Address: userPID.Address, Id: userPID.Id},
+		})
+	}
+	for _, summary := range state.Summaries {
+		snapshot.Posts = append(snapshot.Posts, summary)
+	}
+	sort.Slice(snapshot.Posts, func(i, j int) bool {
+		return snapshot.Posts[i].Timestamp < snapshot.Posts[j].Timestamp
+	})
+	return snapshot
+}
+
+// restoreSnapshot respawns the PostActors from their summaries, after which they recover
+// their own state
+func (state *SubredditActor) restoreSnapshot(context actor.Context, snapshot *proto.SubredditSnapshot) {
+	state.FanoutOnRead = snapshot.FanoutOnRead
+	for _, member := range snapshot.Members {
+		state.Members[member.Username] = state.env.pid(context, member.UserPid)
+	}
+	for _, summary := range snapshot.Posts {
+		state.applyPostAdded(context, &proto.SubredditPostAdded{Post: summary})
+	}
+}
+
+// handleReplayComplete asks every post for the scores it recovered, since they are not
+// journaled here. Listings wait until all of them have answered.
+func (state *SubredditActor) handleReplayComplete(context actor.Context) {
+	state.scoresPending = len(state.Posts)
+	for postID, postPID := range state.Posts {
+		postID := postID
+		future := context.RequestFuture(postPID, &proto.GetPostDetails{}, scoresTimeout)
+		context.ReenterAfter(future, func(res interface{}, err error) {
+			if post, ok := res.(*proto.Post); ok {
+				state.updateScores(postID, post.Upvotes, post.Downvotes, post.CommentCount, post.RepostCount)
+			} else {
+				fmt.Printf("Post %s did not report its scores: %v\n", postID, err)
+			}
+			state.scoresPending--
+			if state.scoresPending == 0 {
+				for _, listing := range state.waiting {
+					listing()
+				}
+				state.waiting = nil
+			}
+		})
+	}
+}
+
+// JoinSubreddit and LeaveSubreddit are journaled as they are. Notifying the members is
+// skipped while they are replayed, since the UserActors recover on their own.
 func (state *SubredditActor) handleJoinSubreddit(context actor.Context, msg *proto.JoinSubreddit) {
-	userPID := actor.NewPID(msg.UserPid.Address, msg.UserPid.Id)
+	if !state.Recovering() {
+		state.PersistReceive(msg)
+	}
+	userPID := state.env.pid(context, msg.UserPid)
 	state.Members[msg.Username] = userPID
-	fmt.Printf("Client %s joined subreddit %s\n", msg.Username, state.Name)
+
+	// Once a subreddit is too large to push to, it stays on fan-out-on-read
+	switched := !state.FanoutOnRead && len(state.Members) > state.env.FanoutLimit
+	if switched {
+		state.FanoutOnRead = true
+	}
+	if state.Recovering() {
+		return
+	}
+	fmt.Printf("Client %s joined subreddit %s\n", msg.Username, state.SubredditName)
+
+	if switched {
+		fmt.Printf("Subreddit %s switched to fan-out-on-read with %d members\n", state.SubredditName, len(state.Members))
+		for username, memberPID := range state.Members {
+			if username != msg.Username {
+				context.Send(memberPID, &proto.FanoutModeChanged{
+					SubredditName: state.SubredditName,
+					FanoutOnRead:  true,
+				})
+			}
+		}
+	}
 
 	// Notify UserActor about the subscription
+	msg.FanoutOnRead = state.FanoutOnRead
 	context.Send(userPID, msg)
 }
 
-func (state *SubredditActor) handleLeaveSubreddit(_ actor.Context, msg *proto.LeaveSubreddit) {
+func (state *SubredditActor) handleLeaveSubreddit(context actor.Context, msg *proto.LeaveSubreddit) {
+	if !state.Recovering() {
+		state.PersistReceive(msg)
+	}
+	if state.Recovering() {
+		delete(state.Members, msg.Username)
+		return
+	}
+	if userPID, exists := state.Members[msg.Username]; exists {
+		// Notify UserActor so it drops the subscription and its timeline posts
+		context.Send(userPID, msg)
+	}
 	delete(state.Members, msg.Username)
-	fmt.Printf("Client %s left subreddit %s\n", msg.Username, state.Name)
+	fmt.Printf("Client %s left subreddit %s\n", msg.Username, state.SubredditName)
 }
 
 func (state *SubredditActor) handlePostToSubreddit(context actor.Context, msg *proto.PostToSubreddit) {
+	sender := context.Sender()
 	// Generate a unique post ID
-	postID := fmt.Sprintf("%s_%d", state.Name, len(state.Posts)+1)
+	postID := fmt.Sprintf("%s_%d", state.SubredditName, len(state.Posts)+1)
 
-	postProps := actor.PropsFromProducer(func() actor.Actor {
-		return NewPostActor(postID, msg.Content, msg.Author, state.Name, state.EnginePID)
-	})
-	postPID := context.Spawn(postProps)
-	state.Posts[postID] = postPID
+	summary := &proto.Post{
+		Content:               msg.Content,
+		Author:                msg.Author,
+		SubredditName:         state.SubredditName,
+		Timestamp:             state.env.Clock.Now().Unix(),
+		PostId:                postID,
+		OriginalPostId:        msg.OriginalPostId,
+		OriginalSubredditName: msg.OriginalSubredditName,
+	}
+
+	state.PersistReceive(&proto.SubredditPostAdded{Post: summary})
+	postPID := state.applyPostAdded(context, &proto.SubredditPostAdded{Post: summary})
+	if postPID == nil {
+		context.Send(sender, &proto.PostResponse{
+			Success: false,
+			Message: "Failed to create post",
+		})
+		return
+	}
 
-	fmt.Printf("Client %s posted to subreddit %s\n", msg.Author, state.Name)
+	fmt.Printf("Client %s posted to subreddit %s\n", msg.Author, state.SubredditName)
 
-	// Notify members
-	for _, userPID := range state.Members {
+	// Register the post with the engine so comments and votes can be routed to it
+	registration := &proto.PostCreated{
+		PostId:        postID,
+		SubredditName: state.SubredditName,
+		Author:        msg.Author,
+		PostPid:       &proto.PID{Address: postPID.Address, Id: postPID.Id},
+		Content:       msg.Content,
+	}
+
+	// Push the new post onto every member's home timeline before responding, so a client
+	// acting on the response never overtakes the notifications
+	if !state.FanoutOnRead {
 		notification := &proto.NewPostNotification{
-			SubredditName: state.Name,
+			SubredditName: state.SubredditName,
 			PostId:        postID,
 			Content:       msg.Content,
 			Author:        msg.Author,
+			Post:          summary,
+		}
+		for _, userPID := range state.Members {
+			context.Send(userPID, notification)
 		}
-		context.Send(userPID, notification)
 	}
+
+	state.env.registerWithEngine(context, registration, func() {
+		context.Send(sender, &proto.PostResponse{
+			Success: true,
+			Message: "Post created",
+			PostId:  postID,
+		})
+	})
+}
+
+// applyPostAdded spawns the PostActor, which recovers its votes and comments from the journal
+func (state *SubredditActor) applyPostAdded(context actor.Context, event *proto.SubredditPostAdded) *actor.PID {
+	summary := event.Post
+	postPID, err := state.env.spawnGrain(context, KindPost, summary.PostId, summary.PostId, func() actor.Actor {
+		return NewPostActor(summary, state.env)
+	})
+	if err != nil {
+		fmt.Printf("Failed to spawn post actor for %s: %v\n", summary.PostId, err)
+		return nil
+	}
+	if state.env.Cluster != nil && !state.Recovering() {
+		context.Send(postPID, event)
+	}
+	state.Posts[summary.PostId] = postPID
+	state.Summaries[summary.PostId] = summary
+	return postPID
 }
 
-func (state *SubredditActor) handleGetSubredditPosts(context actor.Context) {
-	var posts []*proto.Post
-	for _, postPID := range state.Posts {
-		// Request post details from PostActor
-		future := context.RequestFuture(postPID, &proto.GetPostDetails{}, 2*time.Second)
-		result, err := future.Result()
-		if err == nil {
-			post := result.(*proto.Post)
-			posts = append(posts, post)
+// handlePostScoreChanged is not journaled, as a score changes with every vote, comment and
+// repost on the post
+func (state *SubredditActor) handlePostScoreChanged(msg *proto.PostScoreChanged) {
+	state.updateScores(msg.PostId, msg.Upvotes, msg.Downvotes, msg.CommentCount, msg.RepostCount)
+}
+
+func (state *SubredditActor) updateScores(postID string, upvotes, downvotes, commentCount, repostCount int32) {
+	summary, exists := state.Summaries[postID]
+	if !exists {
+		return
+	}
+
+	updated := protobuf.Clone(summary).(*proto.Post)
+	updated.Upvotes = upvotes
+	updated.Downvotes = downvotes
+	updated.CommentCount = commentCount
+	updated.RepostCount = repostCount
+	state.Summaries[postID] = updated
+}
+
+func (state *SubredditActor) handleGetSubredditPosts(context actor.Context, msg *proto.GetSubredditPosts) {
+	if state.scoresPending > 0 {
+		sender := context.Sender()
+		state.waiting = append(state.waiting, func() {
+			context.Send(sender, state.listPosts(msg))
+		})
+		return
+	}
+	context.Respond(state.listPosts(msg))
+}
+
+func (state *SubredditActor) listPosts(msg *proto.GetSubredditPosts) *proto.SubredditPosts {
+	posts := make([]*proto.Post, 0, len(state.Summaries))
+	if len(msg.PostIds) > 0 {
+		for _, postID := range msg.PostIds {
+			if summary, exists := state.Summaries[postID]; exists {
+				posts = append(posts, summary)
+			}
 		}
+	} else {
+		for _, summary := range state.Summaries {
+			posts = append(posts, summary)
+		}
+	}
+
+	mode := msg.Sort
+	if mode == "" {
+		mode = SortNew
 	}
-	response := &proto.SubredditPosts{
-		Posts: posts,
+	page, nextCursor := pagePosts(rankPosts(posts, mode, msg.TimeWindow, state.env.Clock.Now()), msg.Cursor, msg.PageSize)
+	return &proto.SubredditPosts{
+		Posts:      page,
+		NextCursor: nextCursor,
 	}
-	context.Respond(response)
 }
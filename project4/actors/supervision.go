@@ -0,0 +1,108 @@
+package actors
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	log "github.com/sirupsen/logrus"
+)
+
+// SupervisionPolicy is how often an actor of some kind may crash before its parent gives up
+// on it. A crashed actor is restarted, replaying its journal to recover its state; once it
+// crashed more than MaxRestarts times within Window the failure is escalated to its parent
+// instead, which restarts with all of its children.
+type SupervisionPolicy struct {
+	MaxRestarts int
+	Window      time.Duration
+}
+
+// Comments and posts escalate soonest: restarting the post or subreddit above recovers them
+// along with their siblings, which is cheap next to restarting the engine
+var defaultSupervisionPolicies = map[ActorKind]SupervisionPolicy{
+	KindComment:   {MaxRestarts: 3, Window: time.Minute},
+	KindPost:      {MaxRestarts: 3, Window: time.Minute},
+	KindUser:      {MaxRestarts: 5, Window: time.Minute},
+	KindSubreddit: {MaxRestarts: 5, Window: time.Minute},
+	KindEngine:    {MaxRestarts: 10, Window: time.Minute},
+	KindRouter:    {MaxRestarts: 10, Window: time.Minute},
+}
+
+// Supervision is the supervisor strategy of every actor the engine spawns, and of the root
+// guardian of the engine and of the actors spawned next to a ShardRouter. Actors at the root
+// have no parent to escalate to, so they are stopped when they exceed their policy. Grains
+// are activated by the cluster, which supervises them itself.
+//
+// Messages, sessions and presence held only in memory are lost when an actor restarts, as
+// when the engine does; so is the message it crashed on.
+type Supervision struct {
+	policies map[ActorKind]SupervisionPolicy
+	metrics  *Metrics
+	// Kind of every running actor by ID, since a failure only carries the child's PID
+	kinds sync.Map
+}
+
+func newSupervision(policies map[ActorKind]SupervisionPolicy, metrics *Metrics) *Supervision {
+	return &Supervision{policies: policies, metrics: metrics}
+}
+
+// track records the kind of the actor when it starts and forgets it when it stops
+func (supervision *Supervision) track(kind ActorKind) actor.ReceiverMiddleware {
+	return func(next actor.ReceiverFunc) actor.ReceiverFunc {
+		return func(context actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+			switch envelope.Message.(type) {
+			case *actor.Started:
+				supervision.kinds.Store(context.Self().Id, kind)
+			case *actor.Stopped:
+				supervision.kinds.Delete(context.Self().Id)
+			}
+			next(context, envelope)
+		}
+	}
+}
+
+func (supervision *Supervision) kindOf(pid *actor.PID) ActorKind {
+	if kind, ok := supervision.kinds.Load(pid.Id); ok {
+		return kind.(ActorKind)
+	}
+	return ""
+}
+
+func (supervision *Supervision) HandleFailure(_ *actor.ActorSystem, supervisor actor.Supervisor, child *actor.PID, rs *actor.RestartStatistics, reason interface{}, message interface{}) {
+	kind := supervision.kindOf(child)
+	policy, ok := supervision.policies[kind]
+	if !ok {
+		policy = supervision.policies[KindEngine]
+	}
+	rs.Fail()
+	failures := rs.NumberOfFailures(policy.Window)
+
+	directive := actor.RestartDirective
+	if failures > policy.MaxRestarts {
+		directive = actor.EscalateDirective
+		if _, hasParent := supervisor.(actor.Context); !hasParent {
+			directive = actor.StopDirective
+		}
+	}
+	action := strings.ToLower(strings.TrimSuffix(directive.String(), "Directive"))
+	supervision.metrics.crashes.WithLabelValues(string(kind), action).Inc()
+	log.WithFields(log.Fields{
+		"actor":     string(kind),
+		"pid":       child.Id,
+		"reason":    fmt.Sprint(reason),
+		"message":   messageType(message),
+		"failures":  failures,
+		"directive": action,
+	}).Error("Actor crashed")
+
+	switch directive {
+	case actor.RestartDirective:
+		supervisor.RestartChildren(child)
+	case actor.EscalateDirective:
+		supervisor.EscalateFailure(reason, message)
+	case actor.StopDirective:
+		supervisor.StopChildren(child)
+	}
+}
@@ -2,42 +2,94 @@ package actors
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/persistence"
 	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+	"github.com/tejasriramparvathaneni/reddit_clone/utils"
 )
 
+// timelineSize caps the home timeline; older posts drop off the end
+const timelineSize = 500
+
+// pendingLimit caps the notifications held for an offline user; the oldest are dropped
+const pendingLimit = 1000
+
 type UserActor struct {
+	persistence.Mixin
 	Username      string
-	Karma         int32
+	PostKarma     int32
+	CommentKarma  int32
 	Inbox         []*proto.DirectMessage
 	Subscriptions map[string]*actor.PID
+	// Posts pushed by subscribed subreddits, oldest first
+	Timeline []*proto.Post
+	// Subscriptions whose posts are not pushed and must be read at feed time
+	FanoutOnRead map[string]bool
+	Online       bool
+	// Set once a client has connected. Users who only use the REST API never connect, so
+	// nothing is held for them.
+	HasConnected bool
+	// The connected client, if it wants notifications pushed to it
+	ClientPID *actor.PID
+	// Notifications held until the client connects again. Like presence they are not
+	// journaled and are lost when the actor restarts.
+	Pending []*proto.Notification
+	env     *Env
 }
 
-func NewUserActor(username string) actor.Actor {
+func NewUserActor(username string, env *Env) actor.Actor {
 	return &UserActor{
 		Username:      username,
-		Karma:         0,
+		PostKarma:     0,
+		CommentKarma:  0,
 		Inbox:         []*proto.DirectMessage{},
 		Subscriptions: make(map[string]*actor.PID),
+		Timeline:      []*proto.Post{},
+		FanoutOnRead:  make(map[string]bool),
+		env:           env,
 	}
 }
 
 func (state *UserActor) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
+	case *actor.Started, *persistence.ReplayComplete:
+	case *actor.Restarting, *actor.Stopping, *actor.Stopped, *actor.Terminated:
+	case *cluster.ClusterInit:
+		// Grains are activated before they replay their journal
+		state.Username = msg.Identity.Identity
+	case *persistence.RequestSnapshot:
+		state.PersistSnapshot(state.snapshot())
+	case *proto.UserSnapshot:
+		state.restoreSnapshot(context, msg)
+	case *proto.DirectMessage:
+		state.Inbox = append(state.Inbox, msg)
+	case *proto.TimelinePostsAdded:
+		state.addToTimeline(msg.Posts)
+	case *proto.Connect:
+		state.handleConnect(context, msg)
+	case *proto.Disconnect:
+		state.handleDisconnect(context)
 	case *proto.NewPostNotification:
-		fmt.Printf("Client %s received new post notification from subreddit %s\n", state.Username, msg.SubredditName)
+		state.handleNewPostNotification(context, msg)
+	case *proto.FanoutModeChanged:
+		state.handleFanoutModeChanged(msg)
 	case *proto.SendDirectMessage:
 		state.handleSendDirectMessage(context, msg)
 	case *proto.GetInbox:
 		state.handleGetInbox(context, msg)
 	case *proto.UpdateKarma:
 		state.handleUpdateKarma(msg)
+	case *proto.GetUserProfile:
+		state.handleGetUserProfile(context)
 	case *proto.GetFeed:
 		state.handleGetFeed(context, msg)
 	case *proto.JoinSubreddit:
-		state.handleJoinSubreddit(msg)
+		state.handleJoinSubreddit(context, msg)
 	case *proto.LeaveSubreddit:
 		state.handleLeaveSubreddit(msg)
 	default:
@@ -45,56 +97,334 @@ func (state *UserActor) Receive(context actor.Context) {
 	}
 }
 
+// UpdateKarma, JoinSubreddit, LeaveSubreddit and FanoutModeChanged are journaled as they are
+func (state *UserActor) snapshot() *proto.UserSnapshot {
+	snapshot := &proto.UserSnapshot{
+		PostKarma:    state.PostKarma,
+		CommentKarma: state.CommentKarma,
+		Inbox:        append([]*proto.DirectMessage{}, state.Inbox...),
+		Timeline:     append([]*proto.Post{}, state.Timeline...),
+	}
+	for name, subredditPID := range state.Subscriptions {
+		snapshot.Subscriptions = append(snapshot.Subscriptions, &proto.JoinSubreddit{
+			Username:      state.Username,
+			SubredditName: name,
+			SubredditPid:  &proto.PID{Address: subredditPID.Address, Id: subredditPID.Id},
+			FanoutOnRead:  state.FanoutOnRead[name],
+		})
+	}
+	return snapshot
+}
+
+func (state *UserActor) restoreSnapshot(context actor.Context, snapshot *proto.UserSnapshot) {
+	state.PostKarma = snapshot.PostKarma
+	state.CommentKarma = snapshot.CommentKarma
+	state.Inbox = append([]*proto.DirectMessage{}, snapshot.Inbox...)
+	state.Timeline = append([]*proto.Post{}, snapshot.Timeline...)
+	for _, subscription := range snapshot.Subscriptions {
+		state.Subscriptions[subscription.SubredditName] = state.env.pid(context, subscription.SubredditPid)
+		state.FanoutOnRead[subscription.SubredditName] = subscription.FanoutOnRead
+	}
+}
+
 func (state *UserActor) handleUpdateKarma(msg *proto.UpdateKarma) {
-	state.Karma += msg.Amount
-	fmt.Printf("Client %s karma updated to %d\n", state.Username, state.Karma)
+	if !state.Recovering() {
+		state.PersistReceive(msg)
+	}
+	switch msg.Kind {
+	case proto.KarmaKind_COMMENT_KARMA:
+		state.CommentKarma += msg.Amount
+	default:
+		state.PostKarma += msg.Amount
+	}
+	if state.Recovering() {
+		return
+	}
+	if msg.Kind == proto.KarmaKind_COMMENT_KARMA {
+		fmt.Printf("Client %s comment karma updated to %d\n", state.Username, state.CommentKarma)
+	} else {
+		fmt.Printf("Client %s post karma updated to %d\n", state.Username, state.PostKarma)
+	}
+}
+
+func (state *UserActor) handleGetUserProfile(context actor.Context) {
+	context.Respond(&proto.UserProfile{
+		Username:     state.Username,
+		PostKarma:    state.PostKarma,
+		CommentKarma: state.CommentKarma,
+		Karma:        state.PostKarma + state.CommentKarma,
+	})
+}
+
+func (state *UserActor) handleConnect(context actor.Context, msg *proto.Connect) {
+	state.Online = true
+	state.HasConnected = true
+	state.ClientPID = nil
+	if msg.ClientPid != nil {
+		// Clients run in processes of their own
+		state.ClientPID = actor.NewPID(msg.ClientPid.Address, msg.ClientPid.Id)
+	}
+	fmt.Printf("Client %s connected with %d pending notifications\n", state.Username, len(state.Pending))
+
+	for _, notification := range state.Pending {
+		notification.Queued = true
+		state.notifyClient(context, notification)
+	}
+	state.Pending = nil
+	context.Respond(&proto.Presence{
+		Username: state.Username,
+		Online:   true,
+		LastSeen: state.env.Clock.Now().Unix(),
+	})
 }
 
-func (state *UserActor) handleSendDirectMessage(_ actor.Context, msg *proto.SendDirectMessage) {
+func (state *UserActor) handleDisconnect(context actor.Context) {
+	state.Online = false
+	state.ClientPID = nil
+	fmt.Printf("Client %s disconnected\n", state.Username)
+	context.Respond(&proto.Presence{
+		Username: state.Username,
+		Online:   false,
+		LastSeen: state.env.Clock.Now().Unix(),
+	})
+}
+
+// deliver pushes a notification to the connected client, or queues it while a user who has
+// connected before is offline
+func (state *UserActor) deliver(context actor.Context, notification *proto.Notification) {
+	if state.Online {
+		state.notifyClient(context, notification)
+		return
+	}
+	if !state.HasConnected {
+		return
+	}
+	state.Pending = append(state.Pending, notification)
+	if excess := len(state.Pending) - pendingLimit; excess > 0 {
+		state.Pending = state.Pending[excess:]
+	}
+}
+
+func (state *UserActor) notifyClient(context actor.Context, notification *proto.Notification) {
+	// Clients without a PID read their feed and inbox instead
+	if state.ClientPID != nil {
+		context.Send(state.ClientPID, notification)
+	}
+}
+
+func (state *UserActor) handleSendDirectMessage(context actor.Context, msg *proto.SendDirectMessage) {
 	directMessage := &proto.DirectMessage{
 		FromUsername: msg.FromUsername,
 		Content:      msg.Content,
-		Timestamp:    time.Now().Unix(),
+		Timestamp:    state.env.Clock.Now().Unix(),
 	}
+	state.PersistReceive(directMessage)
 	state.Inbox = append(state.Inbox, directMessage)
+	state.deliver(context, &proto.Notification{DirectMessage: directMessage})
 	fmt.Printf("Client %s received a direct message from %s\n", state.Username, msg.FromUsername)
 }
 
-func (state *UserActor) handleGetInbox(context actor.Context, _ *proto.GetInbox) {
+// The inbox only grows at the end, so a cursor is the position of the last message returned
+func (state *UserActor) handleGetInbox(context actor.Context, msg *proto.GetInbox) {
+	start := 0
+	if cursor, err := utils.DecodeCursor(msg.Cursor); err == nil && cursor != nil {
+		if position, err := strconv.Atoi(cursor.ID); err == nil && position >= 0 {
+			start = min(position+1, len(state.Inbox))
+		}
+	}
+
+	end := len(state.Inbox)
+	if msg.PageSize > 0 && start+int(msg.PageSize) < end {
+		end = start + int(msg.PageSize)
+	}
+
 	inbox := &proto.Inbox{
-		Messages: state.Inbox,
+		Messages: state.Inbox[start:end],
+	}
+	if end < len(state.Inbox) {
+		inbox.NextCursor = utils.EncodeCursor(utils.Cursor{ID: strconv.Itoa(end - 1)})
 	}
 	context.Respond(inbox)
 }
 
-func (state *UserActor) handleGetFeed(context actor.Context, _ *proto.GetFeed) {
-	var posts []*proto.Post
+func (state *UserActor) handleNewPostNotification(context actor.Context, msg *proto.NewPostNotification) {
+	fmt.Printf("Client %s received new post notification from subreddit %s\n", state.Username, msg.SubredditName)
+	if _, subscribed := state.Subscriptions[msg.SubredditName]; !subscribed || msg.Post == nil {
+		return
+	}
+	state.persistTimelinePosts([]*proto.Post{msg.Post})
+	state.deliver(context, &proto.Notification{NewPost: msg})
+}
 
-	for _, subredditPID := range state.Subscriptions {
-		if subredditPID != nil {
-			future := context.RequestFuture(subredditPID, &proto.GetSubredditPosts{}, 5*time.Second)
-			result, err := future.Result()
-			if err == nil {
-				subredditPosts := result.(*proto.SubredditPosts).Posts
-				posts = append(posts, subredditPosts...)
-			} else {
-				// If any subreddit times out, we just skip it. Could log error.
-			}
+func (state *UserActor) persistTimelinePosts(posts []*proto.Post) {
+	event := &proto.TimelinePostsAdded{Posts: posts}
+	state.PersistReceive(event)
+	state.addToTimeline(event.Posts)
+}
+
+// addToTimeline merges posts into the timeline by timestamp, skipping posts already
+// on it, and trims it to timelineSize
+func (state *UserActor) addToTimeline(posts []*proto.Post) {
+	seen := make(map[string]bool, len(state.Timeline))
+	for _, post := range state.Timeline {
+		seen[post.PostId] = true
+	}
+	for _, post := range posts {
+		if !seen[post.PostId] {
+			seen[post.PostId] = true
+			state.Timeline = append(state.Timeline, post)
+		}
+	}
+
+	sort.SliceStable(state.Timeline, func(i, j int) bool {
+		return state.Timeline[i].Timestamp < state.Timeline[j].Timestamp
+	})
+	if excess := len(state.Timeline) - timelineSize; excess > 0 {
+		state.Timeline = state.Timeline[excess:]
+	}
+}
+
+func (state *UserActor) removeFromTimeline(subredditName string) {
+	kept := state.Timeline[:0]
+	for _, post := range state.Timeline {
+		if post.SubredditName != subredditName {
+			kept = append(kept, post)
 		}
 	}
+	state.Timeline = kept
+}
+
+func (state *UserActor) handleFanoutModeChanged(msg *proto.FanoutModeChanged) {
+	if _, subscribed := state.Subscriptions[msg.SubredditName]; !subscribed {
+		return
+	}
+	if !state.Recovering() {
+		state.PersistReceive(msg)
+	}
+	state.FanoutOnRead[msg.SubredditName] = msg.FanoutOnRead
+	if msg.FanoutOnRead {
+		// Feeds now read this subreddit directly
+		state.removeFromTimeline(msg.SubredditName)
+	}
+}
+
+// feedDeadline bounds how long a feed waits for its subreddits; slower ones are left out
+const feedDeadline = 3 * time.Second
 
-	feed := &proto.Feed{
-		Posts: posts,
+// handleGetFeed serves the home timeline, asking the subreddits that are too large to push
+// their posts for the page the feed would take from them, and the others for the current
+// scores of their timeline posts.
+// Timeline posts of subreddits that do not reply in time keep the scores they were pushed with.
+func (state *UserActor) handleGetFeed(context actor.Context, msg *proto.GetFeed) {
+	sender := context.Sender()
+
+	timelineIDs := make(map[string][]string)
+	for _, post := range state.Timeline {
+		timelineIDs[post.SubredditName] = append(timelineIDs[post.SubredditName], post.PostId)
+	}
+	subreddits := make(map[string]*actor.PID)
+	for name, subredditPID := range state.Subscriptions {
+		if state.FanoutOnRead[name] || len(timelineIDs[name]) > 0 {
+			subreddits[name] = subredditPID
+		}
+	}
+	// Subreddits read at feed time send the page the feed would take from them, ranked
+	// and cut the same way, so it is merged with the timeline before paging
+	mode := msg.Sort
+	if mode == "" {
+		mode = SortHot
+	}
+	request := func(name string) interface{} {
+		if state.FanoutOnRead[name] {
+			return &proto.GetSubredditPosts{
+				Sort:       mode,
+				TimeWindow: msg.TimeWindow,
+				Cursor:     msg.Cursor,
+				PageSize:   msg.PageSize,
+			}
+		}
+		return &proto.GetSubredditPosts{PostIds: timelineIDs[name]}
 	}
-	context.Respond(feed)
+
+	gatherEach(context, subreddits, request, feedDeadline, func(replies map[string]interface{}, timedOut []string) {
+		current := make(map[string]*proto.Post)
+		var posts []*proto.Post
+		// Set when a subreddit has posts past the page it sent
+		more := false
+		for name, reply := range replies {
+			subredditPosts, ok := reply.(*proto.SubredditPosts)
+			if !ok {
+				continue
+			}
+			more = more || subredditPosts.NextCursor != ""
+			for _, post := range subredditPosts.Posts {
+				if state.FanoutOnRead[name] {
+					posts = append(posts, post)
+				} else {
+					current[post.PostId] = post
+				}
+			}
+		}
+		for _, post := range state.Timeline {
+			if updated, ok := current[post.PostId]; ok {
+				post = updated
+			}
+			posts = append(posts, post)
+		}
+
+		ranked := rankPosts(posts, mode, msg.TimeWindow, state.env.Clock.Now())
+		page, nextCursor := pagePosts(ranked, msg.Cursor, msg.PageSize)
+		if nextCursor == "" && more && len(ranked) > 0 {
+			// The page is full and ends with the last post gathered; the next one continues after it
+			nextCursor = ranked[len(ranked)-1].cursor()
+		}
+		sort.Strings(timedOut)
+		feed := &proto.Feed{
+			Posts:              page,
+			NextCursor:         nextCursor,
+			TimedOutSubreddits: timedOut,
+		}
+		context.Send(sender, feed)
+	})
 }
 
-func (state *UserActor) handleJoinSubreddit(msg *proto.JoinSubreddit) {
-	subredditPID := actor.NewPID(msg.SubredditPid.Address, msg.SubredditPid.Id)
+func (state *UserActor) handleJoinSubreddit(context actor.Context, msg *proto.JoinSubreddit) {
+	if !state.Recovering() {
+		state.PersistReceive(msg)
+	}
+	subredditPID := state.env.pid(context, msg.SubredditPid)
 	state.Subscriptions[msg.SubredditName] = subredditPID
+	state.FanoutOnRead[msg.SubredditName] = msg.FanoutOnRead
+
+	// The backfill was journaled as TimelinePostsAdded the first time round
+	if state.Recovering() {
+		return
+	}
 	fmt.Printf("Client %s subscribed to subreddit %s\n", state.Username, msg.SubredditName)
+
+	if msg.FanoutOnRead {
+		return
+	}
+
+	// Backfill the timeline with the subreddit's recent posts; new ones will be pushed
+	backfill := &proto.GetSubredditPosts{Sort: SortNew, PageSize: timelineSize}
+	future := context.RequestFuture(subredditPID, backfill, feedDeadline)
+	context.ReenterAfter(future, func(res interface{}, err error) {
+		subredditPosts, ok := res.(*proto.SubredditPosts)
+		if err != nil || !ok {
+			return
+		}
+		if _, subscribed := state.Subscriptions[msg.SubredditName]; subscribed && len(subredditPosts.Posts) > 0 {
+			state.persistTimelinePosts(subredditPosts.Posts)
+		}
+	})
 }
 
 func (state *UserActor) handleLeaveSubreddit(msg *proto.LeaveSubreddit) {
+	if !state.Recovering() {
+		state.PersistReceive(msg)
+	}
 	delete(state.Subscriptions, msg.SubredditName)
+	delete(state.FanoutOnRead, msg.SubredditName)
+	state.removeFromTimeline(msg.SubredditName)
 }
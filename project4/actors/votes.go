@@ -0,0 +1,53 @@
+package actors
+
+import "github.com/tejasriramparvathaneni/reddit_clone/proto"
+
+// Vote values kept per voter: 1 for an upvote, -1 for a downvote. No entry means no vote.
+func voteValue(upvote, retract bool) int32 {
+	switch {
+	case retract:
+		return 0
+	case upvote:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// applyVote replaces the voter's previous vote with value, keeps the up/down counters in
+// step and returns the net score change, which is zero when the vote did not change
+func applyVote(votes map[string]int32, upvotes, downvotes *int32, voter string, value int32) int32 {
+	previous := votes[voter]
+	if previous == value {
+		return 0
+	}
+
+	switch previous {
+	case 1:
+		*upvotes--
+	case -1:
+		*downvotes--
+	}
+	switch value {
+	case 1:
+		*upvotes++
+	case -1:
+		*downvotes++
+	}
+
+	if value == 0 {
+		delete(votes, voter)
+	} else {
+		votes[voter] = value
+	}
+	return value - previous
+}
+
+// votesSnapshot lists the votes that are still cast, for replaying through applyVote
+func votesSnapshot(votes map[string]int32) []*proto.VoteCast {
+	snapshot := make([]*proto.VoteCast, 0, len(votes))
+	for voter, value := range votes {
+		snapshot = append(snapshot, &proto.VoteCast{Voter: voter, Value: value})
+	}
+	return snapshot
+}
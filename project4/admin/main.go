@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/remote"
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+)
+
+// Admin commands are sent to a running engine over remoting:
+//
+//	admin -engine 127.0.0.1:8081 compact   drop journal events covered by snapshots
+//	admin -engine 127.0.0.1:8081 recovery  show how long actors took to recover at startup
+//	admin -engine 127.0.0.1:8081 add-shard add a shard to a sharded engine and rebalance
+func main() {
+	engineAddress := flag.String("engine", "127.0.0.1:8080", "Remote address of the engine")
+	flag.Parse()
+
+	var request interface{}
+	switch flag.Arg(0) {
+	case "compact":
+		request = &proto.CompactJournals{}
+	case "recovery":
+		request = &proto.GetRecoveryStats{}
+	case "add-shard":
+		request = &proto.AddEngineShard{}
+	default:
+		fmt.Println("Usage: admin [-engine host:port] compact|recovery|add-shard")
+		os.Exit(2)
+	}
+
+	if _, err := proto.RegisterRemoteMessages(); err != nil {
+		fmt.Printf("Failed to register messages for remoting: %v\n", err)
+		os.Exit(1)
+	}
+	system := actor.NewActorSystem()
+	remoting := remote.NewRemote(system, remote.Configure("127.0.0.1", 0))
+	remoting.Start()
+	defer remoting.Shutdown(true)
+
+	enginePID := actor.NewPID(*engineAddress, "engine")
+	res, err := system.Root.RequestFuture(enginePID, request, 30*time.Second).Result()
+	if err != nil {
+		fmt.Printf("Engine did not respond: %v\n", err)
+		os.Exit(1)
+	}
+
+	switch response := res.(type) {
+	case *proto.CompactJournalsResponse:
+		if !response.Success {
+			fmt.Println(response.Message)
+			os.Exit(1)
+		}
+		fmt.Printf("Deleted %d events from the journals of %d actors\n", response.EventsDeleted, response.ActorsCompacted)
+	case *proto.RecoveryStats:
+		for _, recovery := range response.Actors {
+			fmt.Printf("%-10s %6d actors  total %8dus  slowest %8dus\n", recovery.Kind, recovery.Count, recovery.TotalMicros, recovery.MaxMicros)
+		}
+		fmt.Printf("Recovery finished %dus after the engine started\n", response.TotalMicros)
+	case *proto.AddEngineShardResponse:
+		if !response.Success {
+			fmt.Println(response.Message)
+			os.Exit(1)
+		}
+		fmt.Printf("%s, the engine has %d shards\n", response.Message, response.Shards)
+	default:
+		fmt.Printf("Unexpected response: %T\n", res)
+		os.Exit(1)
+	}
+}
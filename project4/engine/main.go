@@ -1,24 +1,101 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-
-	_ "net/http/pprof"
+	"strings"
 
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/remote"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/tejasriramparvathaneni/reddit_clone/actors"
+	"github.com/tejasriramparvathaneni/reddit_clone/journal"
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+	"github.com/tejasriramparvathaneni/reddit_clone/storage"
 )
 
 func main() {
+	journalPath := flag.String("journal", "", "BoltDB file the engine is persisted to; kept in memory when empty")
+	snapshotIntervals := flag.String("snapshot-intervals", "", "Events between snapshots per actor kind, e.g. post=100,comment=100")
+	storageBackend := flag.String("storage", "", "Storage backend: memory, bolt or sqlite; none by default")
+	storagePath := flag.String("storage-path", "reddit.db", "Database file for the bolt and sqlite storage backends")
+	metricsAddr := flag.String("metrics-addr", "localhost:6060", "Address serving /metrics")
+	profiling := flag.Bool("pprof", false, "Also serve /debug/pprof on -metrics-addr")
+	shards := flag.Int("shards", 0, "Engine shards behind a router, 0 runs a single engine; grows a sharded engine started with fewer")
+	clusterMembers := flag.String("cluster-members", "", "Comma-separated host:port of every cluster member's -cluster-port, this one included; runs without a cluster when empty")
+	clusterName := flag.String("cluster-name", "reddit", "Name of the cluster to join")
+	clusterPort := flag.Int("cluster-port", 6330, "Port serving this member's cluster status")
+	flag.Parse()
+
+	intervals, err := actors.ParseSnapshotIntervals(*snapshotIntervals)
+	if err != nil {
+		fmt.Printf("Invalid -snapshot-intervals: %v\n", err)
+		return
+	}
+	var opts []actors.EngineOption
+	for kind, interval := range intervals {
+		opts = append(opts, actors.WithSnapshotInterval(kind, interval))
+	}
+
+	if *storageBackend != "" {
+		repository, err := storage.Open(*storageBackend, *storagePath)
+		if err != nil {
+			fmt.Printf("Failed to open %s storage: %v\n", *storageBackend, err)
+			return
+		}
+		defer repository.Close()
+		opts = append(opts, actors.WithRepository(repository))
+	}
+	opts = append(opts, actors.WithMetrics(actors.NewMetrics(prometheus.DefaultRegisterer)))
+	if *shards > 0 {
+		opts = append(opts, actors.WithShards(*shards))
+	}
+	provider := journal.NewMemoryProvider(journal.DefaultSnapshotInterval)
+	if *journalPath != "" {
+		boltProvider, err := journal.NewBoltProvider(*journalPath, journal.DefaultSnapshotInterval)
+		if err != nil {
+			fmt.Printf("Failed to open journal %s: %v\n", *journalPath, err)
+			return
+		}
+		defer boltProvider.Close()
+		provider = boltProvider
+	}
+	opts = append(opts, actors.WithJournal(provider))
+
+	if _, err := proto.RegisterRemoteMessages(); err != nil {
+		fmt.Printf("Failed to register messages for remoting: %v\n", err)
+		return
+	}
 	system := actor.NewActorSystem()
-	remoteConfig := remote.Configure("127.0.0.1", 8080)
-	remoting := remote.NewRemote(system, remoteConfig)
-	remoting.Start()
+	var engineProps *actor.Props
+	if *clusterMembers != "" {
+		// Users, subreddits and posts become grains spread over the members
+		node := actors.NewNode(opts...)
+		node.Start(system, actors.ClusterConfig{
+			Name:       *clusterName,
+			Host:       "127.0.0.1",
+			Port:       8080,
+			ManagePort: *clusterPort,
+			Members:    strings.Split(*clusterMembers, ","),
+		}, nil)
+		engineProps = node.EngineProps()
+
+		// The other nodes keep their grains here too, so grains recover on any node
+		if _, err := system.Root.SpawnNamed(actor.PropsFromProducer(func() actor.Actor {
+			return journal.NewJournalServer(provider)
+		}), "journal"); err != nil {
+			fmt.Printf("Failed to spawn journal server: %v\n", err)
+			return
+		}
+	} else {
+		remoteConfig := remote.Configure("127.0.0.1", 8080)
+		remoting := remote.NewRemote(system, remoteConfig)
+		remoting.Start()
+		engineProps = actors.NewEngineProps(opts...)
+	}
+
+	actors.ServeMetrics(*metricsAddr, *profiling)
 
-	engineProps := actor.PropsFromProducer(func() actor.Actor {
-		return actors.NewEngineActor()
-	})
 	enginePID, err := system.Root.SpawnNamed(engineProps, "engine")
 	if err != nil {
 		fmt.Printf("Failed to spawn engine actor: %v\n", err)
@@ -0,0 +1,292 @@
+package journal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/asynkron/protoactor-go/persistence"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var (
+	eventsBucket    = []byte("events")
+	snapshotsBucket = []byte("snapshots")
+)
+
+// maxGroupedWrites caps the events and snapshots committed together
+const maxGroupedWrites = 1000
+
+// BoltProvider keeps the journal in a BoltDB file. Events are stored per actor under their
+// event index, and only the latest snapshot of each actor is kept.
+//
+// Events and snapshots are written by a single goroutine, which commits everything persisted
+// while the previous commit was syncing in one transaction, so actors persisting at the same
+// time share an fsync. Each persist still returns only once it is on disk.
+type BoltProvider struct {
+	db               *bolt.DB
+	snapshotInterval int
+	writes           chan *boltWrite
+	stopped          chan struct{}
+	// Held to hand over a write, and to close writes once none is in flight
+	closing sync.RWMutex
+	closed  bool
+}
+
+type boltWrite struct {
+	apply func(tx *bolt.Tx) error
+	done  chan error
+}
+
+func NewBoltProvider(path string, snapshotInterval int) (*BoltProvider, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(eventsBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(snapshotsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	provider := &BoltProvider{
+		db:               db,
+		snapshotInterval: snapshotInterval,
+		writes:           make(chan *boltWrite, maxGroupedWrites),
+		stopped:          make(chan struct{}),
+	}
+	go provider.writeGroups()
+	return provider, nil
+}
+
+// Close waits for the pending writes; persisting afterwards fails
+func (provider *BoltProvider) Close() error {
+	provider.closing.Lock()
+	provider.closed = true
+	provider.closing.Unlock()
+	close(provider.writes)
+	<-provider.stopped
+	return provider.db.Close()
+}
+
+// write hands apply to the writer goroutine and waits for it to be committed
+func (provider *BoltProvider) write(apply func(tx *bolt.Tx) error) error {
+	provider.closing.RLock()
+	if provider.closed {
+		provider.closing.RUnlock()
+		return bolt.ErrDatabaseNotOpen
+	}
+	write := &boltWrite{apply: apply, done: make(chan error, 1)}
+	provider.writes <- write
+	provider.closing.RUnlock()
+	return <-write.done
+}
+
+func (provider *BoltProvider) writeGroups() {
+	defer close(provider.stopped)
+	for write := range provider.writes {
+		group := []*boltWrite{write}
+	collect:
+		for len(group) < maxGroupedWrites {
+			select {
+			case write, ok := <-provider.writes:
+				if !ok {
+					break collect
+				}
+				group = append(group, write)
+			default:
+				break collect
+			}
+		}
+		provider.commit(group)
+	}
+}
+
+// commit applies group in one transaction. If that fails each write is retried on its own,
+// so one bad write does not fail the others.
+func (provider *BoltProvider) commit(group []*boltWrite) {
+	err := provider.db.Update(func(tx *bolt.Tx) error {
+		for _, write := range group {
+			if err := write.apply(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil && len(group) > 1 {
+		for _, write := range group {
+			write.done <- provider.db.Update(write.apply)
+		}
+		return
+	}
+	for _, write := range group {
+		write.done <- err
+	}
+}
+
+func (provider *BoltProvider) GetState() persistence.ProviderState {
+	return provider
+}
+
+func (provider *BoltProvider) Restart() {}
+
+func (provider *BoltProvider) GetSnapshotInterval() int {
+	return provider.snapshotInterval
+}
+
+func (provider *BoltProvider) GetSnapshot(actorName string) (snapshot interface{}, eventIndex int, ok bool) {
+	provider.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(snapshotsBucket).Get([]byte(actorName))
+		if len(value) < 8 {
+			return nil
+		}
+		message, err := unmarshalAny(value[8:])
+		if err != nil {
+			fmt.Printf("Failed to read snapshot of %s: %v\n", actorName, err)
+			return nil
+		}
+		snapshot, eventIndex, ok = message, int(binary.BigEndian.Uint64(value[:8])), true
+		return nil
+	})
+	return snapshot, eventIndex, ok
+}
+
+func (provider *BoltProvider) PersistSnapshot(actorName string, snapshotIndex int, snapshot proto.Message) {
+	data, err := marshalAny(snapshot)
+	if err != nil {
+		fmt.Printf("Failed to encode snapshot of %s: %v\n", actorName, err)
+		return
+	}
+	value := append(indexKey(snapshotIndex), data...)
+	err = provider.write(func(tx *bolt.Tx) error {
+		return tx.Bucket(snapshotsBucket).Put([]byte(actorName), value)
+	})
+	if err != nil {
+		fmt.Printf("Failed to persist snapshot of %s: %v\n", actorName, err)
+	}
+}
+
+func (provider *BoltProvider) DeleteSnapshots(actorName string, inclusiveToIndex int) {
+	provider.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(snapshotsBucket)
+		value := bucket.Get([]byte(actorName))
+		if len(value) >= 8 && int(binary.BigEndian.Uint64(value[:8])) <= inclusiveToIndex {
+			return bucket.Delete([]byte(actorName))
+		}
+		return nil
+	})
+}
+
+func (provider *BoltProvider) GetEvents(actorName string, eventIndexStart int, eventIndexEnd int, callback func(e interface{})) {
+	provider.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(eventsBucket).Bucket([]byte(actorName))
+		if bucket == nil {
+			return nil
+		}
+		cursor := bucket.Cursor()
+		for key, value := cursor.Seek(indexKey(eventIndexStart)); key != nil; key, value = cursor.Next() {
+			if eventIndexEnd != 0 && int(binary.BigEndian.Uint64(key)) >= eventIndexEnd {
+				break
+			}
+			event, err := unmarshalAny(value)
+			if err != nil {
+				fmt.Printf("Failed to read event %d of %s: %v\n", binary.BigEndian.Uint64(key), actorName, err)
+				continue
+			}
+			callback(event)
+		}
+		return nil
+	})
+}
+
+func (provider *BoltProvider) PersistEvent(actorName string, eventIndex int, event proto.Message) {
+	data, err := marshalAny(event)
+	if err != nil {
+		fmt.Printf("Failed to encode event %d of %s: %v\n", eventIndex, actorName, err)
+		return
+	}
+	err = provider.write(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(eventsBucket).CreateBucketIfNotExists([]byte(actorName))
+		if err != nil {
+			return err
+		}
+		return bucket.Put(indexKey(eventIndex), data)
+	})
+	if err != nil {
+		fmt.Printf("Failed to persist event %d of %s: %v\n", eventIndex, actorName, err)
+	}
+}
+
+func (provider *BoltProvider) DeleteEvents(actorName string, inclusiveToIndex int) {
+	provider.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(eventsBucket).Bucket([]byte(actorName))
+		if bucket == nil {
+			return nil
+		}
+		cursor := bucket.Cursor()
+		for key, _ := cursor.First(); key != nil && int(binary.BigEndian.Uint64(key)) <= inclusiveToIndex; key, _ = cursor.First() {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Compact deletes every event already covered by the actor's latest snapshot
+func (provider *BoltProvider) Compact() (actors int, events int, err error) {
+	err = provider.db.Update(func(tx *bolt.Tx) error {
+		allEvents := tx.Bucket(eventsBucket)
+		return tx.Bucket(snapshotsBucket).ForEach(func(actorName, value []byte) error {
+			bucket := allEvents.Bucket(actorName)
+			if bucket == nil || len(value) < 8 {
+				return nil
+			}
+			// The snapshot was taken before the event at its index was applied
+			snapshotIndex := binary.BigEndian.Uint64(value[:8])
+			deleted := 0
+			cursor := bucket.Cursor()
+			for key, _ := cursor.First(); key != nil && binary.BigEndian.Uint64(key) < snapshotIndex; key, _ = cursor.First() {
+				if err := bucket.Delete(key); err != nil {
+					return err
+				}
+				deleted++
+			}
+			if deleted > 0 {
+				actors++
+				events += deleted
+			}
+			return nil
+		})
+	})
+	return actors, events, err
+}
+
+func indexKey(index int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(index))
+	return key
+}
+
+func marshalAny(message proto.Message) ([]byte, error) {
+	wrapped, err := anypb.New(message)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(wrapped)
+}
+
+func unmarshalAny(data []byte) (proto.Message, error) {
+	wrapped := &anypb.Any{}
+	if err := proto.Unmarshal(data, wrapped); err != nil {
+		return nil, err
+	}
+	return wrapped.UnmarshalNew()
+}
@@ -0,0 +1,32 @@
+package journal
+
+import (
+	"github.com/asynkron/protoactor-go/persistence"
+)
+
+type intervalProvider struct {
+	provider persistence.Provider
+	interval int
+}
+
+type intervalState struct {
+	persistence.ProviderState
+	interval int
+}
+
+// WithSnapshotInterval shares provider's storage but asks actors for a snapshot every
+// interval events instead of the provider's own interval
+func WithSnapshotInterval(provider persistence.Provider, interval int) persistence.Provider {
+	return &intervalProvider{provider: provider, interval: interval}
+}
+
+func (provider *intervalProvider) GetState() persistence.ProviderState {
+	return &intervalState{
+		ProviderState: provider.provider.GetState(),
+		interval:      provider.interval,
+	}
+}
+
+func (state *intervalState) GetSnapshotInterval() int {
+	return state.interval
+}
@@ -0,0 +1,46 @@
+package journal
+
+import (
+	"github.com/asynkron/protoactor-go/persistence"
+)
+
+// DefaultSnapshotInterval is the number of events an actor journals between snapshots
+const DefaultSnapshotInterval = 1000
+
+// Compactor is implemented by journals that can drop the events covered by snapshots
+type Compactor interface {
+	Compact() (actors int, events int, err error)
+}
+
+type memoryProvider struct {
+	state memoryState
+}
+
+// NewMemoryProvider returns a journal kept in process memory. It is lost when the process exits.
+func NewMemoryProvider(snapshotInterval int) persistence.Provider {
+	return &memoryProvider{state: memoryState{persistence.NewInMemoryProvider(snapshotInterval)}}
+}
+
+func (provider *memoryProvider) GetState() persistence.ProviderState {
+	return provider.state
+}
+
+type memoryState struct {
+	*persistence.InMemoryProvider
+}
+
+// GetEvents stops at the last event when eventIndexEnd lies beyond it, where the
+// InMemoryProvider would panic. A JournalServer asks for pages of a fixed size.
+func (state memoryState) GetEvents(actorName string, eventIndexStart int, eventIndexEnd int, callback func(e interface{})) {
+	if eventIndexEnd == 0 {
+		state.InMemoryProvider.GetEvents(actorName, eventIndexStart, 0, callback)
+		return
+	}
+	index := eventIndexStart
+	state.InMemoryProvider.GetEvents(actorName, eventIndexStart, 0, func(event interface{}) {
+		if index < eventIndexEnd {
+			callback(event)
+		}
+		index++
+	})
+}
@@ -0,0 +1,53 @@
+package journal
+
+import (
+	"github.com/asynkron/protoactor-go/persistence"
+	"google.golang.org/protobuf/proto"
+)
+
+type namesProvider struct {
+	provider persistence.Provider
+	rename   func(string) string
+}
+
+type namesState struct {
+	persistence.ProviderState
+	rename func(string) string
+}
+
+// WithNames shares provider's storage but files every actor under rename(name). Persistence
+// names actors by their PID, which is not stable for actors the cluster activates
+func WithNames(provider persistence.Provider, rename func(string) string) persistence.Provider {
+	return &namesProvider{provider: provider, rename: rename}
+}
+
+func (provider *namesProvider) GetState() persistence.ProviderState {
+	return &namesState{
+		ProviderState: provider.provider.GetState(),
+		rename:        provider.rename,
+	}
+}
+
+func (state *namesState) GetSnapshot(actorName string) (snapshot interface{}, eventIndex int, ok bool) {
+	return state.ProviderState.GetSnapshot(state.rename(actorName))
+}
+
+func (state *namesState) PersistSnapshot(actorName string, snapshotIndex int, snapshot proto.Message) {
+	state.ProviderState.PersistSnapshot(state.rename(actorName), snapshotIndex, snapshot)
+}
+
+func (state *namesState) DeleteSnapshots(actorName string, inclusiveToIndex int) {
+	state.ProviderState.DeleteSnapshots(state.rename(actorName), inclusiveToIndex)
+}
+
+func (state *namesState) GetEvents(actorName string, eventIndexStart int, eventIndexEnd int, callback func(e interface{})) {
+	state.ProviderState.GetEvents(state.rename(actorName), eventIndexStart, eventIndexEnd, callback)
+}
+
+func (state *namesState) PersistEvent(actorName string, eventIndex int, event proto.Message) {
+	state.ProviderState.PersistEvent(state.rename(actorName), eventIndex, event)
+}
+
+func (state *namesState) DeleteEvents(actorName string, inclusiveToIndex int) {
+	state.ProviderState.DeleteEvents(state.rename(actorName), inclusiveToIndex)
+}
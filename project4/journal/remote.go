@@ -0,0 +1,211 @@
+package journal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/persistence"
+	messages "github.com/tejasriramparvathaneni/reddit_clone/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// remoteTimeout bounds every request to the JournalServer
+const remoteTimeout = 5 * time.Second
+
+// eventsPage caps the events returned for one GetJournalEvents, keeping replies well under
+// the remoting message size limit
+const eventsPage = 500
+
+// JournalServer serves provider to RemoteProviders in other processes. The engine runs it so
+// every cluster node keeps its grains in the engine's journal.
+type JournalServer struct {
+	state persistence.ProviderState
+}
+
+func NewJournalServer(provider persistence.Provider) actor.Actor {
+	return &JournalServer{state: provider.GetState()}
+}
+
+func (server *JournalServer) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *messages.GetJournalSnapshot:
+		server.handleGetSnapshot(context, msg)
+	case *messages.PersistJournalSnapshot:
+		server.change(context, func() {
+			if snapshot, err := unmarshalAny(msg.Snapshot); err != nil {
+				fmt.Printf("Failed to read snapshot of %s: %v\n", msg.ActorName, err)
+			} else {
+				server.state.PersistSnapshot(msg.ActorName, int(msg.Index), snapshot)
+			}
+		})
+	case *messages.DeleteJournalSnapshots:
+		server.change(context, func() {
+			server.state.DeleteSnapshots(msg.ActorName, int(msg.InclusiveToIndex))
+		})
+	case *messages.GetJournalEvents:
+		server.handleGetEvents(context, msg)
+	case *messages.PersistJournalEvent:
+		server.change(context, func() {
+			if event, err := unmarshalAny(msg.Event); err != nil {
+				fmt.Printf("Failed to read event %d of %s: %v\n", msg.Index, msg.ActorName, err)
+			} else {
+				server.state.PersistEvent(msg.ActorName, int(msg.Index), event)
+			}
+		})
+	case *messages.DeleteJournalEvents:
+		server.change(context, func() {
+			server.state.DeleteEvents(msg.ActorName, int(msg.InclusiveToIndex))
+		})
+	}
+}
+
+// change makes a change to the journal on a goroutine of its own and acknowledges it once it
+// is stored, so changes from different nodes are written together rather than one after the
+// other. A RemoteProvider waits for each change to be acknowledged, which keeps the changes
+// to any one actor in order.
+func (server *JournalServer) change(context actor.Context, apply func()) {
+	sender := context.Sender()
+	root := context.ActorSystem().Root
+	go func() {
+		apply()
+		root.Send(sender, &messages.JournalAck{})
+	}()
+}
+
+func (server *JournalServer) handleGetSnapshot(context actor.Context, msg *messages.GetJournalSnapshot) {
+	response := &messages.JournalSnapshot{}
+	if snapshot, index, ok := server.state.GetSnapshot(msg.ActorName); ok {
+		data, err := marshalAny(snapshot.(proto.Message))
+		if err != nil {
+			fmt.Printf("Failed to encode snapshot of %s: %v\n", msg.ActorName, err)
+		} else {
+			response = &messages.JournalSnapshot{Found: true, Index: int64(index), Snapshot: data}
+		}
+	}
+	context.Respond(response)
+}
+
+// handleGetEvents reads one page of events, so a page costs the same however long the journal is
+func (server *JournalServer) handleGetEvents(context actor.Context, msg *messages.GetJournalEvents) {
+	end := int(msg.Start) + eventsPage
+	if msg.End != 0 && int(msg.End) < end {
+		end = int(msg.End)
+	}
+	response := &messages.JournalEvents{}
+	read := 0
+	server.state.GetEvents(msg.ActorName, int(msg.Start), end, func(event interface{}) {
+		read++
+		data, err := marshalAny(event.(proto.Message))
+		if err != nil {
+			fmt.Printf("Failed to encode an event of %s: %v\n", msg.ActorName, err)
+			return
+		}
+		response.Events = append(response.Events, data)
+	})
+	response.More = read == eventsPage && end != int(msg.End)
+	context.Respond(response)
+}
+
+// RemoteProvider is a journal kept by a JournalServer in another process. Like the
+// BoltProvider it answers once the server has stored a change, and reports failures without
+// returning them, since persistence has no way to.
+type RemoteProvider struct {
+	root             *actor.RootContext
+	server           *actor.PID
+	snapshotInterval int
+}
+
+func NewRemoteProvider(system *actor.ActorSystem, server *actor.PID, snapshotInterval int) *RemoteProvider {
+	return &RemoteProvider{root: system.Root, server: server, snapshotInterval: snapshotInterval}
+}
+
+func (provider *RemoteProvider) GetState() persistence.ProviderState {
+	return provider
+}
+
+func (provider *RemoteProvider) Restart() {}
+
+func (provider *RemoteProvider) GetSnapshotInterval() int {
+	return provider.snapshotInterval
+}
+
+func (provider *RemoteProvider) request(msg interface{}) (interface{}, error) {
+	return provider.root.RequestFuture(provider.server, msg, remoteTimeout).Result()
+}
+
+// change sends a request that changes the journal and waits for it to be stored
+func (provider *RemoteProvider) change(actorName string, msg interface{}) {
+	if _, err := provider.request(msg); err != nil {
+		fmt.Printf("Journal did not store %T of %s: %v\n", msg, actorName, err)
+	}
+}
+
+func (provider *RemoteProvider) GetSnapshot(actorName string) (snapshot interface{}, eventIndex int, ok bool) {
+	res, err := provider.request(&messages.GetJournalSnapshot{ActorName: actorName})
+	response, isSnapshot := res.(*messages.JournalSnapshot)
+	if err != nil || !isSnapshot {
+		fmt.Printf("Failed to read snapshot of %s: %v\n", actorName, err)
+		return nil, 0, false
+	}
+	if !response.Found {
+		return nil, 0, false
+	}
+	message, err := unmarshalAny(response.Snapshot)
+	if err != nil {
+		fmt.Printf("Failed to read snapshot of %s: %v\n", actorName, err)
+		return nil, 0, false
+	}
+	return message, int(response.Index), true
+}
+
+func (provider *RemoteProvider) PersistSnapshot(actorName string, snapshotIndex int, snapshot proto.Message) {
+	data, err := marshalAny(snapshot)
+	if err != nil {
+		fmt.Printf("Failed to encode snapshot of %s: %v\n", actorName, err)
+		return
+	}
+	provider.change(actorName, &messages.PersistJournalSnapshot{ActorName: actorName, Index: int64(snapshotIndex), Snapshot: data})
+}
+
+func (provider *RemoteProvider) DeleteSnapshots(actorName string, inclusiveToIndex int) {
+	provider.change(actorName, &messages.DeleteJournalSnapshots{ActorName: actorName, InclusiveToIndex: int64(inclusiveToIndex)})
+}
+
+// GetEvents reads the events a page at a time; events are numbered without gaps from the
+// start of a replay, so each page starts eventsPage after the previous one
+func (provider *RemoteProvider) GetEvents(actorName string, eventIndexStart int, eventIndexEnd int, callback func(e interface{})) {
+	for start := eventIndexStart; ; {
+		res, err := provider.request(&messages.GetJournalEvents{ActorName: actorName, Start: int64(start), End: int64(eventIndexEnd)})
+		page, ok := res.(*messages.JournalEvents)
+		if err != nil || !ok {
+			fmt.Printf("Failed to read events of %s from %d: %v\n", actorName, start, err)
+			return
+		}
+		for _, data := range page.Events {
+			event, err := unmarshalAny(data)
+			if err != nil {
+				fmt.Printf("Failed to read an event of %s: %v\n", actorName, err)
+				continue
+			}
+			callback(event)
+		}
+		if !page.More {
+			return
+		}
+		start += eventsPage
+	}
+}
+
+func (provider *RemoteProvider) PersistEvent(actorName string, eventIndex int, event proto.Message) {
+	data, err := marshalAny(event)
+	if err != nil {
+		fmt.Printf("Failed to encode event %d of %s: %v\n", eventIndex, actorName, err)
+		return
+	}
+	provider.change(actorName, &messages.PersistJournalEvent{ActorName: actorName, Index: int64(eventIndex), Event: data})
+}
+
+func (provider *RemoteProvider) DeleteEvents(actorName string, inclusiveToIndex int) {
+	provider.change(actorName, &messages.DeleteJournalEvents{ActorName: actorName, InclusiveToIndex: int64(inclusiveToIndex)})
+}
@@ -3,7 +3,10 @@ package models
 import "github.com/asynkron/protoactor-go/actor"
 
 type Comment struct {
-	Content string
-	Author  string
-	PID     *actor.PID
+	CommentID string
+	PostID    string
+	Content   string
+	Author    string
+	PID       *actor.PID `json:"-"`
+	PostPID   *actor.PID `json:"-"`
 }
@@ -0,0 +1,8 @@
+package models
+
+type Message struct {
+	FromUsername string
+	ToUsername   string
+	Content      string
+	Timestamp    int64
+}
@@ -3,7 +3,9 @@ package models
 import "github.com/asynkron/protoactor-go/actor"
 
 type Post struct {
-	Content string
-	Author  string
-	PID     *actor.PID
+	PostID        string
+	SubredditName string
+	Content       string
+	Author        string
+	PID           *actor.PID `json:"-"`
 }
@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+type Session struct {
+	Token     string
+	Username  string
+	ExpiresAt time.Time
+}
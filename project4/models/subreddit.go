@@ -4,5 +4,5 @@ import "github.com/asynkron/protoactor-go/actor"
 
 type Subreddit struct {
 	Name string
-	PID  *actor.PID
+	PID  *actor.PID `json:"-"`
 }
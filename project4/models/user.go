@@ -3,7 +3,7 @@ package models
 import "github.com/asynkron/protoactor-go/actor"
 
 type User struct {
-	Username string
-	Password string
-	PID      *actor.PID
+	Username     string
+	PasswordHash string
+	PID          *actor.PID `json:"-"`
 }
@@ -0,0 +1,48 @@
+#!/bin/sh
+# Runs the engine and two remote clients as separate processes on localhost. alice waits for
+# bob's two posts and direct message to be pushed to her process; the script fails unless
+# both clients exit cleanly. With "cluster" as argument the engine runs as a cluster member
+# alongside a node, so users, subreddits and posts are grains spread over two processes.
+set -e
+cd "$(dirname "$0")"
+
+bin=$(mktemp -d)
+trap 'kill $engine $node 2>/dev/null; rm -rf "$bin"' EXIT
+
+go build -o "$bin/engine" ./engine
+go build -o "$bin/remote_client" ./remote_client
+
+if [ "$1" = cluster ]; then
+	go build -o "$bin/node" ./node
+	members=localhost:6330,localhost:6331
+	"$bin/engine" -metrics-addr localhost:0 -cluster-port 6330 -cluster-members $members >"$bin/engine.log" 2>&1 &
+	engine=$!
+	"$bin/node" -metrics-addr localhost:0 -cluster-port 6331 -cluster-members $members >"$bin/node.log" 2>&1 &
+	node=$!
+	# Members need a round of status polling to see each other
+	sleep 4
+else
+	"$bin/engine" -metrics-addr localhost:0 >"$bin/engine.log" 2>&1 &
+	engine=$!
+	sleep 1
+fi
+
+"$bin/remote_client" -user alice -subreddit multiprocess -expect 3 >"$bin/alice.log" 2>&1 &
+alice=$!
+# bob only posts once alice has joined
+until grep -q "joined" "$bin/alice.log"; do
+	kill -0 $alice 2>/dev/null || break
+	sleep 0.1
+done
+
+status=0
+"$bin/remote_client" -user bob -subreddit multiprocess -posts 2 -message alice || status=1
+wait $alice || status=1
+cat "$bin/alice.log"
+
+if [ $status -ne 0 ]; then
+	echo "Multi-process run failed, engine log:"
+	tail -20 "$bin/engine.log"
+	exit 1
+fi
+echo "Multi-process run passed"
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tejasriramparvathaneni/reddit_clone/actors"
+	"github.com/tejasriramparvathaneni/reddit_clone/journal"
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+)
+
+// A node is a cluster member without the engine: it hosts its share of the user, subreddit
+// and post grains, journaling them to the engine. Start the engine with -cluster-members,
+// then a node per extra member:
+//
+//	engine -cluster-port 6330 -cluster-members localhost:6330,localhost:6331
+//	node -port 8090 -cluster-port 6331 -cluster-members localhost:6330,localhost:6331
+func main() {
+	engineAddress := flag.String("engine", "127.0.0.1:8080", "Remote address of the engine")
+	port := flag.Int("port", 8090, "Port the node's actors are reached on")
+	clusterMembers := flag.String("cluster-members", "localhost:6330,localhost:6331", "Comma-separated host:port of every cluster member's -cluster-port, this one included")
+	clusterName := flag.String("cluster-name", "reddit", "Name of the cluster to join")
+	clusterPort := flag.Int("cluster-port", 6331, "Port serving this member's cluster status")
+	snapshotIntervals := flag.String("snapshot-intervals", "", "Events between snapshots per actor kind, e.g. post=100,comment=100")
+	metricsAddr := flag.String("metrics-addr", "localhost:6061", "Address serving /metrics")
+	profiling := flag.Bool("pprof", false, "Also serve /debug/pprof on -metrics-addr")
+	flag.Parse()
+
+	intervals, err := actors.ParseSnapshotIntervals(*snapshotIntervals)
+	if err != nil {
+		fmt.Printf("Invalid -snapshot-intervals: %v\n", err)
+		return
+	}
+	var opts []actors.EngineOption
+	for kind, interval := range intervals {
+		opts = append(opts, actors.WithSnapshotInterval(kind, interval))
+	}
+	opts = append(opts, actors.WithMetrics(actors.NewMetrics(prometheus.DefaultRegisterer)))
+
+	if _, err := proto.RegisterRemoteMessages(); err != nil {
+		fmt.Printf("Failed to register messages for remoting: %v\n", err)
+		return
+	}
+	system := actor.NewActorSystem()
+	// Grains move between nodes as members come and go, so they are kept in the engine's journal
+	journalPID := actor.NewPID(*engineAddress, "journal")
+	opts = append(opts, actors.WithJournal(journal.NewRemoteProvider(system, journalPID, journal.DefaultSnapshotInterval)))
+	actors.NewNode(opts...).Start(system, actors.ClusterConfig{
+		Name:       *clusterName,
+		Host:       "127.0.0.1",
+		Port:       *port,
+		ManagePort: *clusterPort,
+		Members:    strings.Split(*clusterMembers, ","),
+	}, actor.NewPID(*engineAddress, "engine"))
+
+	actors.ServeMetrics(*metricsAddr, *profiling)
+
+	fmt.Printf("Node is running on 127.0.0.1:%d\n", *port)
+
+	select {}
+}
@@ -20,7 +20,53 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Define PID message
+type KarmaKind int32
+
+const (
+	KarmaKind_POST_KARMA    KarmaKind = 0
+	KarmaKind_COMMENT_KARMA KarmaKind = 1
+)
+
+// Enum value maps for KarmaKind.
+var (
+	KarmaKind_name = map[int32]string{
+		0: "POST_KARMA",
+		1: "COMMENT_KARMA",
+	}
+	KarmaKind_value = map[string]int32{
+		"POST_KARMA":    0,
+		"COMMENT_KARMA": 1,
+	}
+)
+
+func (x KarmaKind) Enum() *KarmaKind {
+	p := new(KarmaKind)
+	*p = x
+	return p
+}
+
+func (x KarmaKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KarmaKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (KarmaKind) Type() protoreflect.EnumType {
+	return &file_proto_messages_proto_enumTypes[0]
+}
+
+func (x KarmaKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KarmaKind.Descriptor instead.
+func (KarmaKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{0}
+}
+
+// PID message
 type PID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -241,6 +287,7 @@ type AuthenticationResponse struct {
 
 	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Token   string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"` // Session token, set when success is true
 }
 
 func (x *AuthenticationResponse) Reset() {
@@ -287,29 +334,35 @@ func (x *AuthenticationResponse) GetMessage() string {
 	return ""
 }
 
-type UpdateKarma struct {
+func (x *AuthenticationResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ValidateSession struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Amount   int32  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Can be positive or negative
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *UpdateKarma) Reset() {
-	*x = UpdateKarma{}
+func (x *ValidateSession) Reset() {
+	*x = ValidateSession{}
 	mi := &file_proto_messages_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateKarma) String() string {
+func (x *ValidateSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateKarma) ProtoMessage() {}
+func (*ValidateSession) ProtoMessage() {}
 
-func (x *UpdateKarma) ProtoReflect() protoreflect.Message {
+func (x *ValidateSession) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -321,49 +374,41 @@ func (x *UpdateKarma) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateKarma.ProtoReflect.Descriptor instead.
-func (*UpdateKarma) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateSession.ProtoReflect.Descriptor instead.
+func (*ValidateSession) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdateKarma) GetUsername() string {
+func (x *ValidateSession) GetToken() string {
 	if x != nil {
-		return x.Username
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UpdateKarma) GetAmount() int32 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-type SendDirectMessage struct {
+type SessionInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromUsername string `protobuf:"bytes,1,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"`
-	ToUsername   string `protobuf:"bytes,2,opt,name=to_username,json=toUsername,proto3" json:"to_username,omitempty"`
-	Content      string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Valid    bool   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *SendDirectMessage) Reset() {
-	*x = SendDirectMessage{}
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
 	mi := &file_proto_messages_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendDirectMessage) String() string {
+func (x *SessionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendDirectMessage) ProtoMessage() {}
+func (*SessionInfo) ProtoMessage() {}
 
-func (x *SendDirectMessage) ProtoReflect() protoreflect.Message {
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -375,56 +420,47 @@ func (x *SendDirectMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendDirectMessage.ProtoReflect.Descriptor instead.
-func (*SendDirectMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SendDirectMessage) GetFromUsername() string {
-	if x != nil {
-		return x.FromUsername
-	}
-	return ""
-}
-
-func (x *SendDirectMessage) GetToUsername() string {
+func (x *SessionInfo) GetValid() bool {
 	if x != nil {
-		return x.ToUsername
+		return x.Valid
 	}
-	return ""
+	return false
 }
 
-func (x *SendDirectMessage) GetContent() string {
+func (x *SessionInfo) GetUsername() string {
 	if x != nil {
-		return x.Content
+		return x.Username
 	}
 	return ""
 }
 
-type DirectMessage struct {
+type Logout struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromUsername string `protobuf:"bytes,1,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"`
-	Content      string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Timestamp    int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *DirectMessage) Reset() {
-	*x = DirectMessage{}
+func (x *Logout) Reset() {
+	*x = Logout{}
 	mi := &file_proto_messages_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DirectMessage) String() string {
+func (x *Logout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DirectMessage) ProtoMessage() {}
+func (*Logout) ProtoMessage() {}
 
-func (x *DirectMessage) ProtoReflect() protoreflect.Message {
+func (x *Logout) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -436,54 +472,43 @@ func (x *DirectMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DirectMessage.ProtoReflect.Descriptor instead.
-func (*DirectMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use Logout.ProtoReflect.Descriptor instead.
+func (*Logout) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DirectMessage) GetFromUsername() string {
-	if x != nil {
-		return x.FromUsername
-	}
-	return ""
-}
-
-func (x *DirectMessage) GetContent() string {
+func (x *Logout) GetToken() string {
 	if x != nil {
-		return x.Content
+		return x.Token
 	}
 	return ""
 }
 
-func (x *DirectMessage) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-type GetInbox struct {
+type ChangePassword struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Username    string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	OldPassword string `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	KeepToken   string `protobuf:"bytes,4,opt,name=keep_token,json=keepToken,proto3" json:"keep_token,omitempty"` // Session that stays valid, all others are revoked
 }
 
-func (x *GetInbox) Reset() {
-	*x = GetInbox{}
+func (x *ChangePassword) Reset() {
+	*x = ChangePassword{}
 	mi := &file_proto_messages_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInbox) String() string {
+func (x *ChangePassword) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInbox) ProtoMessage() {}
+func (*ChangePassword) ProtoMessage() {}
 
-func (x *GetInbox) ProtoReflect() protoreflect.Message {
+func (x *ChangePassword) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -495,40 +520,62 @@ func (x *GetInbox) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInbox.ProtoReflect.Descriptor instead.
-func (*GetInbox) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePassword.ProtoReflect.Descriptor instead.
+func (*ChangePassword) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetInbox) GetUsername() string {
+func (x *ChangePassword) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-type Inbox struct {
+func (x *ChangePassword) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePassword) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ChangePassword) GetKeepToken() string {
+	if x != nil {
+		return x.KeepToken
+	}
+	return ""
+}
+
+type PasswordChangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Messages []*DirectMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Inbox) Reset() {
-	*x = Inbox{}
+func (x *PasswordChangeResponse) Reset() {
+	*x = PasswordChangeResponse{}
 	mi := &file_proto_messages_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Inbox) String() string {
+func (x *PasswordChangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Inbox) ProtoMessage() {}
+func (*PasswordChangeResponse) ProtoMessage() {}
 
-func (x *Inbox) ProtoReflect() protoreflect.Message {
+func (x *PasswordChangeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -540,41 +587,49 @@ func (x *Inbox) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Inbox.ProtoReflect.Descriptor instead.
-func (*Inbox) Descriptor() ([]byte, []int) {
+// Deprecated: Use PasswordChangeResponse.ProtoReflect.Descriptor instead.
+func (*PasswordChangeResponse) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Inbox) GetMessages() []*DirectMessage {
+func (x *PasswordChangeResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Messages
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// Subreddit Messages
-type CreateSubreddit struct {
+func (x *PasswordChangeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateKarma struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Username string    `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Amount   int32     `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Can be positive or negative
+	Kind     KarmaKind `protobuf:"varint,3,opt,name=kind,proto3,enum=redditclone.KarmaKind" json:"kind,omitempty"`
 }
 
-func (x *CreateSubreddit) Reset() {
-	*x = CreateSubreddit{}
+func (x *UpdateKarma) Reset() {
+	*x = UpdateKarma{}
 	mi := &file_proto_messages_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSubreddit) String() string {
+func (x *UpdateKarma) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubreddit) ProtoMessage() {}
+func (*UpdateKarma) ProtoMessage() {}
 
-func (x *CreateSubreddit) ProtoReflect() protoreflect.Message {
+func (x *UpdateKarma) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -586,43 +641,54 @@ func (x *CreateSubreddit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubreddit.ProtoReflect.Descriptor instead.
-func (*CreateSubreddit) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateKarma.ProtoReflect.Descriptor instead.
+func (*UpdateKarma) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateSubreddit) GetName() string {
+func (x *UpdateKarma) GetUsername() string {
 	if x != nil {
-		return x.Name
+		return x.Username
 	}
 	return ""
 }
 
-type JoinSubreddit struct {
+func (x *UpdateKarma) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateKarma) GetKind() KarmaKind {
+	if x != nil {
+		return x.Kind
+	}
+	return KarmaKind_POST_KARMA
+}
+
+type GetUserProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	SubredditName string `protobuf:"bytes,2,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
-	UserPid       *PID   `protobuf:"bytes,3,opt,name=user_pid,json=userPid,proto3" json:"user_pid,omitempty"`
-	SubredditPid  *PID   `protobuf:"bytes,4,opt,name=subreddit_pid,json=subredditPid,proto3" json:"subreddit_pid,omitempty"` // Added field
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *JoinSubreddit) Reset() {
-	*x = JoinSubreddit{}
+func (x *GetUserProfile) Reset() {
+	*x = GetUserProfile{}
 	mi := &file_proto_messages_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinSubreddit) String() string {
+func (x *GetUserProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinSubreddit) ProtoMessage() {}
+func (*GetUserProfile) ProtoMessage() {}
 
-func (x *JoinSubreddit) ProtoReflect() protoreflect.Message {
+func (x *GetUserProfile) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -634,62 +700,43 @@ func (x *JoinSubreddit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinSubreddit.ProtoReflect.Descriptor instead.
-func (*JoinSubreddit) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserProfile.ProtoReflect.Descriptor instead.
+func (*GetUserProfile) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *JoinSubreddit) GetUsername() string {
+func (x *GetUserProfile) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *JoinSubreddit) GetSubredditName() string {
-	if x != nil {
-		return x.SubredditName
-	}
-	return ""
-}
-
-func (x *JoinSubreddit) GetUserPid() *PID {
-	if x != nil {
-		return x.UserPid
-	}
-	return nil
-}
-
-func (x *JoinSubreddit) GetSubredditPid() *PID {
-	if x != nil {
-		return x.SubredditPid
-	}
-	return nil
-}
-
-type LeaveSubreddit struct {
+type UserProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	SubredditName string `protobuf:"bytes,2,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	Username     string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PostKarma    int32  `protobuf:"varint,2,opt,name=post_karma,json=postKarma,proto3" json:"post_karma,omitempty"`
+	CommentKarma int32  `protobuf:"varint,3,opt,name=comment_karma,json=commentKarma,proto3" json:"comment_karma,omitempty"`
+	Karma        int32  `protobuf:"varint,4,opt,name=karma,proto3" json:"karma,omitempty"` // post_karma + comment_karma
 }
 
-func (x *LeaveSubreddit) Reset() {
-	*x = LeaveSubreddit{}
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
 	mi := &file_proto_messages_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveSubreddit) String() string {
+func (x *UserProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveSubreddit) ProtoMessage() {}
+func (*UserProfile) ProtoMessage() {}
 
-func (x *LeaveSubreddit) ProtoReflect() protoreflect.Message {
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -701,49 +748,63 @@ func (x *LeaveSubreddit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveSubreddit.ProtoReflect.Descriptor instead.
-func (*LeaveSubreddit) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LeaveSubreddit) GetUsername() string {
+func (x *UserProfile) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *LeaveSubreddit) GetSubredditName() string {
+func (x *UserProfile) GetPostKarma() int32 {
 	if x != nil {
-		return x.SubredditName
+		return x.PostKarma
 	}
-	return ""
+	return 0
 }
 
-type PostToSubreddit struct {
+func (x *UserProfile) GetCommentKarma() int32 {
+	if x != nil {
+		return x.CommentKarma
+	}
+	return 0
+}
+
+func (x *UserProfile) GetKarma() int32 {
+	if x != nil {
+		return x.Karma
+	}
+	return 0
+}
+
+type SendDirectMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content       string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Author        string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	SubredditName string `protobuf:"bytes,3,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	FromUsername string `protobuf:"bytes,1,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"`
+	ToUsername   string `protobuf:"bytes,2,opt,name=to_username,json=toUsername,proto3" json:"to_username,omitempty"`
+	Content      string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *PostToSubreddit) Reset() {
-	*x = PostToSubreddit{}
+func (x *SendDirectMessage) Reset() {
+	*x = SendDirectMessage{}
 	mi := &file_proto_messages_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PostToSubreddit) String() string {
+func (x *SendDirectMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostToSubreddit) ProtoMessage() {}
+func (*SendDirectMessage) ProtoMessage() {}
 
-func (x *PostToSubreddit) ProtoReflect() protoreflect.Message {
+func (x *SendDirectMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -755,57 +816,56 @@ func (x *PostToSubreddit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostToSubreddit.ProtoReflect.Descriptor instead.
-func (*PostToSubreddit) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendDirectMessage.ProtoReflect.Descriptor instead.
+func (*SendDirectMessage) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PostToSubreddit) GetContent() string {
+func (x *SendDirectMessage) GetFromUsername() string {
 	if x != nil {
-		return x.Content
+		return x.FromUsername
 	}
 	return ""
 }
 
-func (x *PostToSubreddit) GetAuthor() string {
+func (x *SendDirectMessage) GetToUsername() string {
 	if x != nil {
-		return x.Author
+		return x.ToUsername
 	}
 	return ""
 }
 
-func (x *PostToSubreddit) GetSubredditName() string {
+func (x *SendDirectMessage) GetContent() string {
 	if x != nil {
-		return x.SubredditName
+		return x.Content
 	}
 	return ""
 }
 
-type NewPostNotification struct {
+type DirectMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubredditName string `protobuf:"bytes,1,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
-	PostId        string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Author        string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	FromUsername string `protobuf:"bytes,1,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"`
+	Content      string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Timestamp    int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *NewPostNotification) Reset() {
-	*x = NewPostNotification{}
+func (x *DirectMessage) Reset() {
+	*x = DirectMessage{}
 	mi := &file_proto_messages_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NewPostNotification) String() string {
+func (x *DirectMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewPostNotification) ProtoMessage() {}
+func (*DirectMessage) ProtoMessage() {}
 
-func (x *NewPostNotification) ProtoReflect() protoreflect.Message {
+func (x *DirectMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -817,59 +877,56 @@ func (x *NewPostNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewPostNotification.ProtoReflect.Descriptor instead.
-func (*NewPostNotification) Descriptor() ([]byte, []int) {
+// Deprecated: Use DirectMessage.ProtoReflect.Descriptor instead.
+func (*DirectMessage) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *NewPostNotification) GetSubredditName() string {
-	if x != nil {
-		return x.SubredditName
-	}
-	return ""
-}
-
-func (x *NewPostNotification) GetPostId() string {
+func (x *DirectMessage) GetFromUsername() string {
 	if x != nil {
-		return x.PostId
+		return x.FromUsername
 	}
 	return ""
 }
 
-func (x *NewPostNotification) GetContent() string {
+func (x *DirectMessage) GetContent() string {
 	if x != nil {
 		return x.Content
 	}
 	return ""
 }
 
-func (x *NewPostNotification) GetAuthor() string {
+func (x *DirectMessage) GetTimestamp() int64 {
 	if x != nil {
-		return x.Author
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-type GetSubredditPosts struct {
+type GetInbox struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Cursor   string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                      // next_cursor of the previous page, empty for the first page
+	PageSize int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 returns every message
 }
 
-func (x *GetSubredditPosts) Reset() {
-	*x = GetSubredditPosts{}
+func (x *GetInbox) Reset() {
+	*x = GetInbox{}
 	mi := &file_proto_messages_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubredditPosts) String() string {
+func (x *GetInbox) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubredditPosts) ProtoMessage() {}
+func (*GetInbox) ProtoMessage() {}
 
-func (x *GetSubredditPosts) ProtoReflect() protoreflect.Message {
+func (x *GetInbox) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -881,33 +938,55 @@ func (x *GetSubredditPosts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubredditPosts.ProtoReflect.Descriptor instead.
-func (*GetSubredditPosts) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInbox.ProtoReflect.Descriptor instead.
+func (*GetInbox) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{15}
 }
 
-type SubredditPosts struct {
+func (x *GetInbox) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetInbox) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetInbox) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Inbox struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Messages   []*DirectMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	NextCursor string           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
 }
 
-func (x *SubredditPosts) Reset() {
-	*x = SubredditPosts{}
+func (x *Inbox) Reset() {
+	*x = Inbox{}
 	mi := &file_proto_messages_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubredditPosts) String() string {
+func (x *Inbox) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubredditPosts) ProtoMessage() {}
+func (*Inbox) ProtoMessage() {}
 
-func (x *SubredditPosts) ProtoReflect() protoreflect.Message {
+func (x *Inbox) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -919,39 +998,48 @@ func (x *SubredditPosts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubredditPosts.ProtoReflect.Descriptor instead.
-func (*SubredditPosts) Descriptor() ([]byte, []int) {
+// Deprecated: Use Inbox.ProtoReflect.Descriptor instead.
+func (*Inbox) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SubredditPosts) GetPosts() []*Post {
+func (x *Inbox) GetMessages() []*DirectMessage {
 	if x != nil {
-		return x.Posts
+		return x.Messages
 	}
 	return nil
 }
 
-// Post Messages
-type GetPostDetails struct {
+func (x *Inbox) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Subreddit Messages
+type CreateSubreddit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetPostDetails) Reset() {
-	*x = GetPostDetails{}
+func (x *CreateSubreddit) Reset() {
+	*x = CreateSubreddit{}
 	mi := &file_proto_messages_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostDetails) String() string {
+func (x *CreateSubreddit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostDetails) ProtoMessage() {}
+func (*CreateSubreddit) ProtoMessage() {}
 
-func (x *GetPostDetails) ProtoReflect() protoreflect.Message {
+func (x *CreateSubreddit) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -963,39 +1051,44 @@ func (x *GetPostDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostDetails.ProtoReflect.Descriptor instead.
-func (*GetPostDetails) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSubreddit.ProtoReflect.Descriptor instead.
+func (*CreateSubreddit) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{17}
 }
 
-type Post struct {
+func (x *CreateSubreddit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type JoinSubreddit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content       string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Author        string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	SubredditName string `protobuf:"bytes,3,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
-	Timestamp     int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Upvotes       int32  `protobuf:"varint,5,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
-	Downvotes     int32  `protobuf:"varint,6,opt,name=downvotes,proto3" json:"downvotes,omitempty"`
-	PostId        string `protobuf:"bytes,7,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	SubredditName string `protobuf:"bytes,2,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	UserPid       *PID   `protobuf:"bytes,3,opt,name=user_pid,json=userPid,proto3" json:"user_pid,omitempty"`
+	SubredditPid  *PID   `protobuf:"bytes,4,opt,name=subreddit_pid,json=subredditPid,proto3" json:"subreddit_pid,omitempty"`    // Added field
+	FanoutOnRead  bool   `protobuf:"varint,5,opt,name=fanout_on_read,json=fanoutOnRead,proto3" json:"fanout_on_read,omitempty"` // Set by SubredditActor when it no longer pushes new posts to members
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
+func (x *JoinSubreddit) Reset() {
+	*x = JoinSubreddit{}
 	mi := &file_proto_messages_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) String() string {
+func (x *JoinSubreddit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*JoinSubreddit) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
+func (x *JoinSubreddit) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_messages_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,85 +1100,3831 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinSubreddit.ProtoReflect.Descriptor instead.
+func (*JoinSubreddit) Descriptor() ([]byte, []int) {
 	return file_proto_messages_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Post) GetContent() string {
+func (x *JoinSubreddit) GetUsername() string {
 	if x != nil {
-		return x.Content
+		return x.Username
 	}
 	return ""
 }
 
-func (x *Post) GetAuthor() string {
+func (x *JoinSubreddit) GetSubredditName() string {
+	if x != nil {
+		return x.SubredditName
+	}
+	return ""
+}
+
+func (x *JoinSubreddit) GetUserPid() *PID {
+	if x != nil {
+		return x.UserPid
+	}
+	return nil
+}
+
+func (x *JoinSubreddit) GetSubredditPid() *PID {
+	if x != nil {
+		return x.SubredditPid
+	}
+	return nil
+}
+
+func (x *JoinSubreddit) GetFanoutOnRead() bool {
+	if x != nil {
+		return x.FanoutOnRead
+	}
+	return false
+}
+
+type LeaveSubreddit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	SubredditName string `protobuf:"bytes,2,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+}
+
+func (x *LeaveSubreddit) Reset() {
+	*x = LeaveSubreddit{}
+	mi := &file_proto_messages_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveSubreddit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveSubreddit) ProtoMessage() {}
+
+func (x *LeaveSubreddit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveSubreddit.ProtoReflect.Descriptor instead.
+func (*LeaveSubreddit) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LeaveSubreddit) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LeaveSubreddit) GetSubredditName() string {
+	if x != nil {
+		return x.SubredditName
+	}
+	return ""
+}
+
+type PostToSubreddit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content               string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Author                string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	SubredditName         string `protobuf:"bytes,3,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	OriginalPostId        string `protobuf:"bytes,4,opt,name=original_post_id,json=originalPostId,proto3" json:"original_post_id,omitempty"` // Set when the post is a crosspost
+	OriginalSubredditName string `protobuf:"bytes,5,opt,name=original_subreddit_name,json=originalSubredditName,proto3" json:"original_subreddit_name,omitempty"`
+}
+
+func (x *PostToSubreddit) Reset() {
+	*x = PostToSubreddit{}
+	mi := &file_proto_messages_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostToSubreddit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostToSubreddit) ProtoMessage() {}
+
+func (x *PostToSubreddit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostToSubreddit.ProtoReflect.Descriptor instead.
+func (*PostToSubreddit) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PostToSubreddit) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostToSubreddit) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *PostToSubreddit) GetSubredditName() string {
+	if x != nil {
+		return x.SubredditName
+	}
+	return ""
+}
+
+func (x *PostToSubreddit) GetOriginalPostId() string {
+	if x != nil {
+		return x.OriginalPostId
+	}
+	return ""
+}
+
+func (x *PostToSubreddit) GetOriginalSubredditName() string {
+	if x != nil {
+		return x.OriginalSubredditName
+	}
+	return ""
+}
+
+type PostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PostId  string `protobuf:"bytes,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *PostResponse) Reset() {
+	*x = PostResponse{}
+	mi := &file_proto_messages_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostResponse) ProtoMessage() {}
+
+func (x *PostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostResponse.ProtoReflect.Descriptor instead.
+func (*PostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PostResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PostResponse) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type NewPostNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubredditName string `protobuf:"bytes,1,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	PostId        string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Author        string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Post          *Post  `protobuf:"bytes,5,opt,name=post,proto3" json:"post,omitempty"` // Summary added to the member's home timeline
+}
+
+func (x *NewPostNotification) Reset() {
+	*x = NewPostNotification{}
+	mi := &file_proto_messages_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewPostNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPostNotification) ProtoMessage() {}
+
+func (x *NewPostNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPostNotification.ProtoReflect.Descriptor instead.
+func (*NewPostNotification) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NewPostNotification) GetSubredditName() string {
+	if x != nil {
+		return x.SubredditName
+	}
+	return ""
+}
+
+func (x *NewPostNotification) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *NewPostNotification) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *NewPostNotification) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *NewPostNotification) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// Presence messages. A connected client receives Notifications as they arrive; while the
+// user is offline they are queued and delivered when the client connects again.
+type Connect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username  string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	ClientPid *PID   `protobuf:"bytes,2,opt,name=client_pid,json=clientPid,proto3" json:"client_pid,omitempty"`
+}
+
+func (x *Connect) Reset() {
+	*x = Connect{}
+	mi := &file_proto_messages_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Connect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connect) ProtoMessage() {}
+
+func (x *Connect) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connect.ProtoReflect.Descriptor instead.
+func (*Connect) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Connect) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Connect) GetClientPid() *PID {
+	if x != nil {
+		return x.ClientPid
+	}
+	return nil
+}
+
+type Disconnect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *Disconnect) Reset() {
+	*x = Disconnect{}
+	mi := &file_proto_messages_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Disconnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Disconnect) ProtoMessage() {}
+
+func (x *Disconnect) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Disconnect.ProtoReflect.Descriptor instead.
+func (*Disconnect) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Disconnect) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewPost       *NewPostNotification `protobuf:"bytes,1,opt,name=new_post,json=newPost,proto3" json:"new_post,omitempty"`
+	DirectMessage *DirectMessage       `protobuf:"bytes,2,opt,name=direct_message,json=directMessage,proto3" json:"direct_message,omitempty"`
+	Queued        bool                 `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"` // Held while the user was offline
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_proto_messages_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Notification) GetNewPost() *NewPostNotification {
+	if x != nil {
+		return x.NewPost
+	}
+	return nil
+}
+
+func (x *Notification) GetDirectMessage() *DirectMessage {
+	if x != nil {
+		return x.DirectMessage
+	}
+	return nil
+}
+
+func (x *Notification) GetQueued() bool {
+	if x != nil {
+		return x.Queued
+	}
+	return false
+}
+
+type GetPresence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *GetPresence) Reset() {
+	*x = GetPresence{}
+	mi := &file_proto_messages_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresence) ProtoMessage() {}
+
+func (x *GetPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresence.ProtoReflect.Descriptor instead.
+func (*GetPresence) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPresence) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type Presence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Online   bool   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	LastSeen int64  `protobuf:"varint,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // When the user last connected or disconnected
+}
+
+func (x *Presence) Reset() {
+	*x = Presence{}
+	mi := &file_proto_messages_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Presence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Presence) ProtoMessage() {}
+
+func (x *Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
+func (*Presence) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Presence) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Presence) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *Presence) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+// Sent to members when a subreddit grows too large to push new posts to every member
+type FanoutModeChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubredditName string `protobuf:"bytes,1,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	FanoutOnRead  bool   `protobuf:"varint,2,opt,name=fanout_on_read,json=fanoutOnRead,proto3" json:"fanout_on_read,omitempty"`
+}
+
+func (x *FanoutModeChanged) Reset() {
+	*x = FanoutModeChanged{}
+	mi := &file_proto_messages_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FanoutModeChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanoutModeChanged) ProtoMessage() {}
+
+func (x *FanoutModeChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanoutModeChanged.ProtoReflect.Descriptor instead.
+func (*FanoutModeChanged) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FanoutModeChanged) GetSubredditName() string {
+	if x != nil {
+		return x.SubredditName
+	}
+	return ""
+}
+
+func (x *FanoutModeChanged) GetFanoutOnRead() bool {
+	if x != nil {
+		return x.FanoutOnRead
+	}
+	return false
+}
+
+type GetSubredditPosts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubredditName string   `protobuf:"bytes,1,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	Sort          string   `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"` // Same modes as GetFeed, defaults to new
+	TimeWindow    string   `protobuf:"bytes,3,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	Cursor        string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32    `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 0 returns every post
+	PostIds       []string `protobuf:"bytes,6,rep,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`     // Only these posts, when set; feeds read current scores with it
+}
+
+func (x *GetSubredditPosts) Reset() {
+	*x = GetSubredditPosts{}
+	mi := &file_proto_messages_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubredditPosts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubredditPosts) ProtoMessage() {}
+
+func (x *GetSubredditPosts) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubredditPosts.ProtoReflect.Descriptor instead.
+func (*GetSubredditPosts) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetSubredditPosts) GetSubredditName() string {
+	if x != nil {
+		return x.SubredditName
+	}
+	return ""
+}
+
+func (x *GetSubredditPosts) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetSubredditPosts) GetTimeWindow() string {
+	if x != nil {
+		return x.TimeWindow
+	}
+	return ""
+}
+
+func (x *GetSubredditPosts) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetSubredditPosts) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSubredditPosts) GetPostIds() []string {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type SubredditPosts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts      []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextCursor string  `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *SubredditPosts) Reset() {
+	*x = SubredditPosts{}
+	mi := &file_proto_messages_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubredditPosts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubredditPosts) ProtoMessage() {}
+
+func (x *SubredditPosts) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubredditPosts.ProtoReflect.Descriptor instead.
+func (*SubredditPosts) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubredditPosts) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *SubredditPosts) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Post Messages
+type GetPostDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPostDetails) Reset() {
+	*x = GetPostDetails{}
+	mi := &file_proto_messages_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostDetails) ProtoMessage() {}
+
+func (x *GetPostDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostDetails.ProtoReflect.Descriptor instead.
+func (*GetPostDetails) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{31}
+}
+
+// Post plus its comment tree; max_depth and limit of 0 mean no limit
+type GetPostWithComments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId   string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	MaxDepth int32  `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"` // Comment levels to include, 1 is top-level comments only
+	Limit    int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                       // Max replies returned under each post or comment
+	After    string `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`                        // Continue the top-level comments after this comment ID
+}
+
+func (x *GetPostWithComments) Reset() {
+	*x = GetPostWithComments{}
+	mi := &file_proto_messages_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostWithComments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostWithComments) ProtoMessage() {}
+
+func (x *GetPostWithComments) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostWithComments.ProtoReflect.Descriptor instead.
+func (*GetPostWithComments) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPostWithComments) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetPostWithComments) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *GetPostWithComments) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPostWithComments) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type PostWithComments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post             *Post          `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Comments         []*CommentNode `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty"`
+	MoreComments     int32          `protobuf:"varint,3,opt,name=more_comments,json=moreComments,proto3" json:"more_comments,omitempty"`              // Top-level comments left out by limit
+	TimedOutComments []string       `protobuf:"bytes,4,rep,name=timed_out_comments,json=timedOutComments,proto3" json:"timed_out_comments,omitempty"` // Top-level comments left out because they missed the deadline
+}
+
+func (x *PostWithComments) Reset() {
+	*x = PostWithComments{}
+	mi := &file_proto_messages_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostWithComments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostWithComments) ProtoMessage() {}
+
+func (x *PostWithComments) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostWithComments.ProtoReflect.Descriptor instead.
+func (*PostWithComments) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PostWithComments) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostWithComments) GetComments() []*CommentNode {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *PostWithComments) GetMoreComments() int32 {
+	if x != nil {
+		return x.MoreComments
+	}
+	return 0
+}
+
+func (x *PostWithComments) GetTimedOutComments() []string {
+	if x != nil {
+		return x.TimedOutComments
+	}
+	return nil
+}
+
+type Post struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content               string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Author                string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	SubredditName         string `protobuf:"bytes,3,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	Timestamp             int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Upvotes               int32  `protobuf:"varint,5,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Downvotes             int32  `protobuf:"varint,6,opt,name=downvotes,proto3" json:"downvotes,omitempty"`
+	PostId                string `protobuf:"bytes,7,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	OriginalPostId        string `protobuf:"bytes,8,opt,name=original_post_id,json=originalPostId,proto3" json:"original_post_id,omitempty"`
+	OriginalSubredditName string `protobuf:"bytes,9,opt,name=original_subreddit_name,json=originalSubredditName,proto3" json:"original_subreddit_name,omitempty"`
+	RepostCount           int32  `protobuf:"varint,10,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	CommentCount          int32  `protobuf:"varint,11,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_proto_messages_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Post) GetSubredditName() string {
+	if x != nil {
+		return x.SubredditName
+	}
+	return ""
+}
+
+func (x *Post) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Post) GetUpvotes() int32 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+func (x *Post) GetDownvotes() int32 {
+	if x != nil {
+		return x.Downvotes
+	}
+	return 0
+}
+
+func (x *Post) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Post) GetOriginalPostId() string {
+	if x != nil {
+		return x.OriginalPostId
+	}
+	return ""
+}
+
+func (x *Post) GetOriginalSubredditName() string {
+	if x != nil {
+		return x.OriginalSubredditName
+	}
+	return ""
+}
+
+func (x *Post) GetRepostCount() int32 {
+	if x != nil {
+		return x.RepostCount
+	}
+	return 0
+}
+
+func (x *Post) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+// Pushed by PostActor to its SubredditActor whenever the post's counters change
+type PostScoreChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId       string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Upvotes      int32  `protobuf:"varint,2,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Downvotes    int32  `protobuf:"varint,3,opt,name=downvotes,proto3" json:"downvotes,omitempty"`
+	CommentCount int32  `protobuf:"varint,4,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	RepostCount  int32  `protobuf:"varint,5,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+}
+
+func (x *PostScoreChanged) Reset() {
+	*x = PostScoreChanged{}
+	mi := &file_proto_messages_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostScoreChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostScoreChanged) ProtoMessage() {}
+
+func (x *PostScoreChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostScoreChanged.ProtoReflect.Descriptor instead.
+func (*PostScoreChanged) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PostScoreChanged) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PostScoreChanged) GetUpvotes() int32 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+func (x *PostScoreChanged) GetDownvotes() int32 {
+	if x != nil {
+		return x.Downvotes
+	}
+	return 0
+}
+
+func (x *PostScoreChanged) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *PostScoreChanged) GetRepostCount() int32 {
+	if x != nil {
+		return x.RepostCount
+	}
+	return 0
+}
+
+type CommentOnPost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Author  string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	PostId  string `protobuf:"bytes,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *CommentOnPost) Reset() {
+	*x = CommentOnPost{}
+	mi := &file_proto_messages_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentOnPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentOnPost) ProtoMessage() {}
+
+func (x *CommentOnPost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentOnPost.ProtoReflect.Descriptor instead.
+func (*CommentOnPost) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CommentOnPost) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommentOnPost) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CommentOnPost) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type VoteOnPost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId  string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Upvote  bool   `protobuf:"varint,2,opt,name=upvote,proto3" json:"upvote,omitempty"`
+	Voter   string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
+	Retract bool   `protobuf:"varint,4,opt,name=retract,proto3" json:"retract,omitempty"` // Clears the voter's vote, upvote is ignored
+}
+
+func (x *VoteOnPost) Reset() {
+	*x = VoteOnPost{}
+	mi := &file_proto_messages_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteOnPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteOnPost) ProtoMessage() {}
+
+func (x *VoteOnPost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteOnPost.ProtoReflect.Descriptor instead.
+func (*VoteOnPost) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VoteOnPost) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *VoteOnPost) GetUpvote() bool {
+	if x != nil {
+		return x.Upvote
+	}
+	return false
+}
+
+func (x *VoteOnPost) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *VoteOnPost) GetRetract() bool {
+	if x != nil {
+		return x.Retract
+	}
+	return false
+}
+
+// Sent by SubredditActor to EngineActor so the engine can route by post_id
+type PostCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId        string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	SubredditName string `protobuf:"bytes,2,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	Author        string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	PostPid       *PID   `protobuf:"bytes,4,opt,name=post_pid,json=postPid,proto3" json:"post_pid,omitempty"`
+	Content       string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *PostCreated) Reset() {
+	*x = PostCreated{}
+	mi := &file_proto_messages_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostCreated) ProtoMessage() {}
+
+func (x *PostCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostCreated.ProtoReflect.Descriptor instead.
+func (*PostCreated) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PostCreated) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PostCreated) GetSubredditName() string {
+	if x != nil {
+		return x.SubredditName
+	}
+	return ""
+}
+
+func (x *PostCreated) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *PostCreated) GetPostPid() *PID {
+	if x != nil {
+		return x.PostPid
+	}
+	return nil
+}
+
+func (x *PostCreated) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// Answers a PostCreated or CommentCreated sent as a request, once the engine routes to it
+type Registered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Registered) Reset() {
+	*x = Registered{}
+	mi := &file_proto_messages_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registered) ProtoMessage() {}
+
+func (x *Registered) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registered.ProtoReflect.Descriptor instead.
+func (*Registered) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{39}
+}
+
+// Comment Messages
+type CommentOnComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content         string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Author          string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	ParentCommentId string `protobuf:"bytes,3,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+}
+
+func (x *CommentOnComment) Reset() {
+	*x = CommentOnComment{}
+	mi := &file_proto_messages_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentOnComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentOnComment) ProtoMessage() {}
+
+func (x *CommentOnComment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentOnComment.ProtoReflect.Descriptor instead.
+func (*CommentOnComment) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CommentOnComment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommentOnComment) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CommentOnComment) GetParentCommentId() string {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return ""
+}
+
+type VoteOnComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId string `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Upvote    bool   `protobuf:"varint,2,opt,name=upvote,proto3" json:"upvote,omitempty"`
+	Voter     string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
+	Retract   bool   `protobuf:"varint,4,opt,name=retract,proto3" json:"retract,omitempty"` // Clears the voter's vote, upvote is ignored
+}
+
+func (x *VoteOnComment) Reset() {
+	*x = VoteOnComment{}
+	mi := &file_proto_messages_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteOnComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteOnComment) ProtoMessage() {}
+
+func (x *VoteOnComment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteOnComment.ProtoReflect.Descriptor instead.
+func (*VoteOnComment) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VoteOnComment) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *VoteOnComment) GetUpvote() bool {
+	if x != nil {
+		return x.Upvote
+	}
+	return false
+}
+
+func (x *VoteOnComment) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *VoteOnComment) GetRetract() bool {
+	if x != nil {
+		return x.Retract
+	}
+	return false
+}
+
+// Comment subtree request; max_depth and limit of 0 mean no limit
+type GetComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId string `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	MaxDepth  int32  `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"` // 1 is the comment itself without replies
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	After     string `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"` // Continue the comment's replies after this comment ID
+}
+
+func (x *GetComment) Reset() {
+	*x = GetComment{}
+	mi := &file_proto_messages_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetComment) ProtoMessage() {}
+
+func (x *GetComment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetComment.ProtoReflect.Descriptor instead.
+func (*GetComment) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetComment) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *GetComment) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *GetComment) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetComment) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// Sent by PostActor to a CommentActor, which answers with a CommentNode
+type GetCommentTree struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxDepth   int32  `protobuf:"varint,1,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	Limit      int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	After      string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	DeadlineMs int64  `protobuf:"varint,4,opt,name=deadline_ms,json=deadlineMs,proto3" json:"deadline_ms,omitempty"` // How long the asker waits for the subtree, the default when 0
+}
+
+func (x *GetCommentTree) Reset() {
+	*x = GetCommentTree{}
+	mi := &file_proto_messages_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentTree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentTree) ProtoMessage() {}
+
+func (x *GetCommentTree) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentTree.ProtoReflect.Descriptor instead.
+func (*GetCommentTree) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetCommentTree) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *GetCommentTree) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCommentTree) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *GetCommentTree) GetDeadlineMs() int64 {
+	if x != nil {
+		return x.DeadlineMs
+	}
+	return 0
+}
+
+type CommentNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId       string         `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Author          string         `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Content         string         `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Upvotes         int32          `protobuf:"varint,4,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Downvotes       int32          `protobuf:"varint,5,opt,name=downvotes,proto3" json:"downvotes,omitempty"`
+	Score           int32          `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	Timestamp       int64          `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Replies         []*CommentNode `protobuf:"bytes,8,rep,name=replies,proto3" json:"replies,omitempty"`
+	MoreReplies     int32          `protobuf:"varint,9,opt,name=more_replies,json=moreReplies,proto3" json:"more_replies,omitempty"`               // Replies left out by max_depth or limit
+	TimedOutReplies []string       `protobuf:"bytes,10,rep,name=timed_out_replies,json=timedOutReplies,proto3" json:"timed_out_replies,omitempty"` // Replies left out because they missed the deadline
+}
+
+func (x *CommentNode) Reset() {
+	*x = CommentNode{}
+	mi := &file_proto_messages_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentNode) ProtoMessage() {}
+
+func (x *CommentNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentNode.ProtoReflect.Descriptor instead.
+func (*CommentNode) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CommentNode) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *CommentNode) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CommentNode) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommentNode) GetUpvotes() int32 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+func (x *CommentNode) GetDownvotes() int32 {
+	if x != nil {
+		return x.Downvotes
+	}
+	return 0
+}
+
+func (x *CommentNode) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CommentNode) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CommentNode) GetReplies() []*CommentNode {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *CommentNode) GetMoreReplies() int32 {
+	if x != nil {
+		return x.MoreReplies
+	}
+	return 0
+}
+
+func (x *CommentNode) GetTimedOutReplies() []string {
+	if x != nil {
+		return x.TimedOutReplies
+	}
+	return nil
+}
+
+// Sent to EngineActor so the engine can route by comment_id
+type CommentCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId  string `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PostId     string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Author     string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	CommentPid *PID   `protobuf:"bytes,4,opt,name=comment_pid,json=commentPid,proto3" json:"comment_pid,omitempty"`
+	Content    string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	PostPid    *PID   `protobuf:"bytes,6,opt,name=post_pid,json=postPid,proto3" json:"post_pid,omitempty"` // Comment messages are routed to the post, whose entry may be on another shard
+}
+
+func (x *CommentCreated) Reset() {
+	*x = CommentCreated{}
+	mi := &file_proto_messages_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentCreated) ProtoMessage() {}
+
+func (x *CommentCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentCreated.ProtoReflect.Descriptor instead.
+func (*CommentCreated) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CommentCreated) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *CommentCreated) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *CommentCreated) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CommentCreated) GetCommentPid() *PID {
+	if x != nil {
+		return x.CommentPid
+	}
+	return nil
+}
+
+func (x *CommentCreated) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommentCreated) GetPostPid() *PID {
+	if x != nil {
+		return x.PostPid
+	}
+	return nil
+}
+
+type CommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CommentId string `protobuf:"bytes,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *CommentResponse) Reset() {
+	*x = CommentResponse{}
+	mi := &file_proto_messages_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentResponse) ProtoMessage() {}
+
+func (x *CommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentResponse.ProtoReflect.Descriptor instead.
+func (*CommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CommentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type VoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success   bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Upvotes   int32  `protobuf:"varint,3,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Downvotes int32  `protobuf:"varint,4,opt,name=downvotes,proto3" json:"downvotes,omitempty"`
+}
+
+func (x *VoteResponse) Reset() {
+	*x = VoteResponse{}
+	mi := &file_proto_messages_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteResponse) ProtoMessage() {}
+
+func (x *VoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteResponse.ProtoReflect.Descriptor instead.
+func (*VoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *VoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VoteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VoteResponse) GetUpvotes() int32 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+func (x *VoteResponse) GetDownvotes() int32 {
+	if x != nil {
+		return x.Downvotes
+	}
+	return 0
+}
+
+// Returned instead of a success response when the target does not exist
+type NotFound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // "user", "subreddit", "post" or "comment"
+	Id   string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *NotFound) Reset() {
+	*x = NotFound{}
+	mi := &file_proto_messages_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotFound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotFound) ProtoMessage() {}
+
+func (x *NotFound) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotFound.ProtoReflect.Descriptor instead.
+func (*NotFound) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *NotFound) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NotFound) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Feed Messages
+type GetFeed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username   string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Sort       string `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`                               // hot, new, top, rising or controversial; defaults to hot
+	TimeWindow string `protobuf:"bytes,3,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"` // hour, day, week or all; only used by top
+	Cursor     string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                           // next_cursor of the previous page, empty for the first page
+	PageSize   int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`      // 0 returns the whole feed
+}
+
+func (x *GetFeed) Reset() {
+	*x = GetFeed{}
+	mi := &file_proto_messages_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeed) ProtoMessage() {}
+
+func (x *GetFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeed.ProtoReflect.Descriptor instead.
+func (*GetFeed) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetFeed) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetFeed) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetFeed) GetTimeWindow() string {
+	if x != nil {
+		return x.TimeWindow
+	}
+	return ""
+}
+
+func (x *GetFeed) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetFeed) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Feed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts              []*Post  `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextCursor         string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`                           // Empty on the last page
+	TimedOutSubreddits []string `protobuf:"bytes,3,rep,name=timed_out_subreddits,json=timedOutSubreddits,proto3" json:"timed_out_subreddits,omitempty"` // Subreddits left out because they missed the deadline
+}
+
+func (x *Feed) Reset() {
+	*x = Feed{}
+	mi := &file_proto_messages_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed) ProtoMessage() {}
+
+func (x *Feed) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
+func (*Feed) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Feed) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *Feed) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *Feed) GetTimedOutSubreddits() []string {
+	if x != nil {
+		return x.TimedOutSubreddits
+	}
+	return nil
+}
+
+// Repost Message
+type Repost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content        string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Author         string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	OriginalPostId string `protobuf:"bytes,3,opt,name=original_post_id,json=originalPostId,proto3" json:"original_post_id,omitempty"`
+	SubredditName  string `protobuf:"bytes,4,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	SubredditPid   *PID   `protobuf:"bytes,5,opt,name=subreddit_pid,json=subredditPid,proto3" json:"subreddit_pid,omitempty"` // Filled in by EngineActor
+}
+
+func (x *Repost) Reset() {
+	*x = Repost{}
+	mi := &file_proto_messages_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Repost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repost) ProtoMessage() {}
+
+func (x *Repost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repost.ProtoReflect.Descriptor instead.
+func (*Repost) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Repost) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Repost) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Repost) GetOriginalPostId() string {
+	if x != nil {
+		return x.OriginalPostId
+	}
+	return ""
+}
+
+func (x *Repost) GetSubredditName() string {
+	if x != nil {
+		return x.SubredditName
+	}
+	return ""
+}
+
+func (x *Repost) GetSubredditPid() *PID {
+	if x != nil {
+		return x.SubredditPid
+	}
+	return nil
+}
+
+// Journal events, persisted by the actors and replayed on restart.
+// PostCreated, CommentCreated, JoinSubreddit, LeaveSubreddit, UpdateKarma,
+// FanoutModeChanged and DirectMessage are journaled as they are.
+type UserRegistered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username     string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PasswordHash string `protobuf:"bytes,2,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+}
+
+func (x *UserRegistered) Reset() {
+	*x = UserRegistered{}
+	mi := &file_proto_messages_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRegistered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRegistered) ProtoMessage() {}
+
+func (x *UserRegistered) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRegistered.ProtoReflect.Descriptor instead.
+func (*UserRegistered) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UserRegistered) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserRegistered) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+type PasswordChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username     string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PasswordHash string `protobuf:"bytes,2,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+}
+
+func (x *PasswordChanged) Reset() {
+	*x = PasswordChanged{}
+	mi := &file_proto_messages_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordChanged) ProtoMessage() {}
+
+func (x *PasswordChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordChanged.ProtoReflect.Descriptor instead.
+func (*PasswordChanged) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PasswordChanged) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PasswordChanged) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+type SubredditCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SubredditCreated) Reset() {
+	*x = SubredditCreated{}
+	mi := &file_proto_messages_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubredditCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubredditCreated) ProtoMessage() {}
+
+func (x *SubredditCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubredditCreated.ProtoReflect.Descriptor instead.
+func (*SubredditCreated) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SubredditCreated) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SubredditPostAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *SubredditPostAdded) Reset() {
+	*x = SubredditPostAdded{}
+	mi := &file_proto_messages_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubredditPostAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubredditPostAdded) ProtoMessage() {}
+
+func (x *SubredditPostAdded) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubredditPostAdded.ProtoReflect.Descriptor instead.
+func (*SubredditPostAdded) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SubredditPostAdded) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type TimelinePostsAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *TimelinePostsAdded) Reset() {
+	*x = TimelinePostsAdded{}
+	mi := &file_proto_messages_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelinePostsAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelinePostsAdded) ProtoMessage() {}
+
+func (x *TimelinePostsAdded) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelinePostsAdded.ProtoReflect.Descriptor instead.
+func (*TimelinePostsAdded) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TimelinePostsAdded) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type CommentAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId string `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PostId    string `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Author    string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Content   string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *CommentAdded) Reset() {
+	*x = CommentAdded{}
+	mi := &file_proto_messages_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentAdded) ProtoMessage() {}
+
+func (x *CommentAdded) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentAdded.ProtoReflect.Descriptor instead.
+func (*CommentAdded) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CommentAdded) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *CommentAdded) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *CommentAdded) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CommentAdded) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommentAdded) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type VoteCast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Value int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // 1, -1 or 0 when the vote was retracted
+}
+
+func (x *VoteCast) Reset() {
+	*x = VoteCast{}
+	mi := &file_proto_messages_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteCast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteCast) ProtoMessage() {}
+
+func (x *VoteCast) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteCast.ProtoReflect.Descriptor instead.
+func (*VoteCast) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *VoteCast) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *VoteCast) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Reposted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Author        string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	SubredditName string `protobuf:"bytes,2,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+}
+
+func (x *Reposted) Reset() {
+	*x = Reposted{}
+	mi := &file_proto_messages_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reposted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reposted) ProtoMessage() {}
+
+func (x *Reposted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reposted.ProtoReflect.Descriptor instead.
+func (*Reposted) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Reposted) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Reposted) GetSubredditName() string {
+	if x != nil {
+		return x.SubredditName
+	}
+	return ""
+}
+
+// Snapshots, written every snapshot interval events so recovery only replays the events
+// journaled after the latest one. Children are listed by the events that created them.
+type EngineSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users      []*UserRegistered   `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Subreddits []*SubredditCreated `protobuf:"bytes,2,rep,name=subreddits,proto3" json:"subreddits,omitempty"`
+	Posts      []*PostCreated      `protobuf:"bytes,3,rep,name=posts,proto3" json:"posts,omitempty"`
+	Comments   []*CommentCreated   `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
+}
+
+func (x *EngineSnapshot) Reset() {
+	*x = EngineSnapshot{}
+	mi := &file_proto_messages_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EngineSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineSnapshot) ProtoMessage() {}
+
+func (x *EngineSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineSnapshot.ProtoReflect.Descriptor instead.
+func (*EngineSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *EngineSnapshot) GetUsers() []*UserRegistered {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *EngineSnapshot) GetSubreddits() []*SubredditCreated {
+	if x != nil {
+		return x.Subreddits
+	}
+	return nil
+}
+
+func (x *EngineSnapshot) GetPosts() []*PostCreated {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *EngineSnapshot) GetComments() []*CommentCreated {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type UserSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostKarma     int32            `protobuf:"varint,1,opt,name=post_karma,json=postKarma,proto3" json:"post_karma,omitempty"`
+	CommentKarma  int32            `protobuf:"varint,2,opt,name=comment_karma,json=commentKarma,proto3" json:"comment_karma,omitempty"`
+	Inbox         []*DirectMessage `protobuf:"bytes,3,rep,name=inbox,proto3" json:"inbox,omitempty"`
+	Subscriptions []*JoinSubreddit `protobuf:"bytes,4,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Timeline      []*Post          `protobuf:"bytes,5,rep,name=timeline,proto3" json:"timeline,omitempty"`
+}
+
+func (x *UserSnapshot) Reset() {
+	*x = UserSnapshot{}
+	mi := &file_proto_messages_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSnapshot) ProtoMessage() {}
+
+func (x *UserSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSnapshot.ProtoReflect.Descriptor instead.
+func (*UserSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UserSnapshot) GetPostKarma() int32 {
+	if x != nil {
+		return x.PostKarma
+	}
+	return 0
+}
+
+func (x *UserSnapshot) GetCommentKarma() int32 {
+	if x != nil {
+		return x.CommentKarma
+	}
+	return 0
+}
+
+func (x *UserSnapshot) GetInbox() []*DirectMessage {
+	if x != nil {
+		return x.Inbox
+	}
+	return nil
+}
+
+func (x *UserSnapshot) GetSubscriptions() []*JoinSubreddit {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *UserSnapshot) GetTimeline() []*Post {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+type SubredditSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members      []*JoinSubreddit `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Posts        []*Post          `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"` // Summaries, oldest first
+	FanoutOnRead bool             `protobuf:"varint,3,opt,name=fanout_on_read,json=fanoutOnRead,proto3" json:"fanout_on_read,omitempty"`
+}
+
+func (x *SubredditSnapshot) Reset() {
+	*x = SubredditSnapshot{}
+	mi := &file_proto_messages_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubredditSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubredditSnapshot) ProtoMessage() {}
+
+func (x *SubredditSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubredditSnapshot.ProtoReflect.Descriptor instead.
+func (*SubredditSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SubredditSnapshot) GetMembers() []*JoinSubreddit {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *SubredditSnapshot) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *SubredditSnapshot) GetFanoutOnRead() bool {
+	if x != nil {
+		return x.FanoutOnRead
+	}
+	return false
+}
+
+type PostSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments    []*CommentAdded   `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"` // Top-level comments
+	Replies     []*CommentCreated `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"`   // Replies at any depth
+	Votes       []*VoteCast       `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes,omitempty"`
+	RepostCount int32             `protobuf:"varint,4,opt,name=repost_count,json=repostCount,proto3" json:"repost_count,omitempty"`
+	Post        *Post             `protobuf:"bytes,5,opt,name=post,proto3" json:"post,omitempty"` // Summary, which post grains are sent once rather than constructed with
+}
+
+func (x *PostSnapshot) Reset() {
+	*x = PostSnapshot{}
+	mi := &file_proto_messages_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSnapshot) ProtoMessage() {}
+
+func (x *PostSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSnapshot.ProtoReflect.Descriptor instead.
+func (*PostSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PostSnapshot) GetComments() []*CommentAdded {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *PostSnapshot) GetReplies() []*CommentCreated {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *PostSnapshot) GetVotes() []*VoteCast {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *PostSnapshot) GetRepostCount() int32 {
+	if x != nil {
+		return x.RepostCount
+	}
+	return 0
+}
+
+func (x *PostSnapshot) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type CommentSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replies []*CommentAdded `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`
+	Votes   []*VoteCast     `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *CommentSnapshot) Reset() {
+	*x = CommentSnapshot{}
+	mi := &file_proto_messages_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentSnapshot) ProtoMessage() {}
+
+func (x *CommentSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentSnapshot.ProtoReflect.Descriptor instead.
+func (*CommentSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CommentSnapshot) GetReplies() []*CommentAdded {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *CommentSnapshot) GetVotes() []*VoteCast {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+// Admin messages
+type CompactJournals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CompactJournals) Reset() {
+	*x = CompactJournals{}
+	mi := &file_proto_messages_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactJournals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactJournals) ProtoMessage() {}
+
+func (x *CompactJournals) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactJournals.ProtoReflect.Descriptor instead.
+func (*CompactJournals) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{65}
+}
+
+type CompactJournalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success         bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message         string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ActorsCompacted int32  `protobuf:"varint,3,opt,name=actors_compacted,json=actorsCompacted,proto3" json:"actors_compacted,omitempty"`
+	EventsDeleted   int32  `protobuf:"varint,4,opt,name=events_deleted,json=eventsDeleted,proto3" json:"events_deleted,omitempty"`
+}
+
+func (x *CompactJournalsResponse) Reset() {
+	*x = CompactJournalsResponse{}
+	mi := &file_proto_messages_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactJournalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactJournalsResponse) ProtoMessage() {}
+
+func (x *CompactJournalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactJournalsResponse.ProtoReflect.Descriptor instead.
+func (*CompactJournalsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CompactJournalsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CompactJournalsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CompactJournalsResponse) GetActorsCompacted() int32 {
+	if x != nil {
+		return x.ActorsCompacted
+	}
+	return 0
+}
+
+func (x *CompactJournalsResponse) GetEventsDeleted() int32 {
+	if x != nil {
+		return x.EventsDeleted
+	}
+	return 0
+}
+
+type GetRecoveryStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRecoveryStats) Reset() {
+	*x = GetRecoveryStats{}
+	mi := &file_proto_messages_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecoveryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecoveryStats) ProtoMessage() {}
+
+func (x *GetRecoveryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecoveryStats.ProtoReflect.Descriptor instead.
+func (*GetRecoveryStats) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{67}
+}
+
+type AddEngineShard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddEngineShard) Reset() {
+	*x = AddEngineShard{}
+	mi := &file_proto_messages_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEngineShard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEngineShard) ProtoMessage() {}
+
+func (x *AddEngineShard) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEngineShard.ProtoReflect.Descriptor instead.
+func (*AddEngineShard) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{68}
+}
+
+type AddEngineShardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Shards  int32  `protobuf:"varint,3,opt,name=shards,proto3" json:"shards,omitempty"`
+}
+
+func (x *AddEngineShardResponse) Reset() {
+	*x = AddEngineShardResponse{}
+	mi := &file_proto_messages_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEngineShardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEngineShardResponse) ProtoMessage() {}
+
+func (x *AddEngineShardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEngineShardResponse.ProtoReflect.Descriptor instead.
+func (*AddEngineShardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AddEngineShardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddEngineShardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddEngineShardResponse) GetShards() int32 {
+	if x != nil {
+		return x.Shards
+	}
+	return 0
+}
+
+type RecoveryStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actors      []*ActorRecovery `protobuf:"bytes,1,rep,name=actors,proto3" json:"actors,omitempty"`
+	TotalMicros int64            `protobuf:"varint,2,opt,name=total_micros,json=totalMicros,proto3" json:"total_micros,omitempty"` // From the engine starting until the last actor recovered
+}
+
+func (x *RecoveryStats) Reset() {
+	*x = RecoveryStats{}
+	mi := &file_proto_messages_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoveryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryStats) ProtoMessage() {}
+
+func (x *RecoveryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryStats.ProtoReflect.Descriptor instead.
+func (*RecoveryStats) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RecoveryStats) GetActors() []*ActorRecovery {
+	if x != nil {
+		return x.Actors
+	}
+	return nil
+}
+
+func (x *RecoveryStats) GetTotalMicros() int64 {
+	if x != nil {
+		return x.TotalMicros
+	}
+	return 0
+}
+
+type ActorRecovery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind        string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Count       int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalMicros int64  `protobuf:"varint,3,opt,name=total_micros,json=totalMicros,proto3" json:"total_micros,omitempty"`
+	MaxMicros   int64  `protobuf:"varint,4,opt,name=max_micros,json=maxMicros,proto3" json:"max_micros,omitempty"`
+}
+
+func (x *ActorRecovery) Reset() {
+	*x = ActorRecovery{}
+	mi := &file_proto_messages_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActorRecovery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActorRecovery) ProtoMessage() {}
+
+func (x *ActorRecovery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActorRecovery.ProtoReflect.Descriptor instead.
+func (*ActorRecovery) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ActorRecovery) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ActorRecovery) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ActorRecovery) GetTotalMicros() int64 {
+	if x != nil {
+		return x.TotalMicros
+	}
+	return 0
+}
+
+func (x *ActorRecovery) GetMaxMicros() int64 {
+	if x != nil {
+		return x.MaxMicros
+	}
+	return 0
+}
+
+// Sharding. The router journals the shards it spawned: ShardsCreated on first start, then
+// ShardAdded for each shard added later and ShardsRebalanced once the existing shards handed
+// it their entries. A shard journals KeysHandedOff with the shards of the new ring, and the
+// new shard journals the ShardHandOff it received.
+type ShardsCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *ShardsCreated) Reset() {
+	*x = ShardsCreated{}
+	mi := &file_proto_messages_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardsCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardsCreated) ProtoMessage() {}
+
+func (x *ShardsCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardsCreated.ProtoReflect.Descriptor instead.
+func (*ShardsCreated) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ShardsCreated) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type ShardAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ShardAdded) Reset() {
+	*x = ShardAdded{}
+	mi := &file_proto_messages_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardAdded) ProtoMessage() {}
+
+func (x *ShardAdded) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardAdded.ProtoReflect.Descriptor instead.
+func (*ShardAdded) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ShardAdded) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ShardsRebalanced struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ShardsRebalanced) Reset() {
+	*x = ShardsRebalanced{}
+	mi := &file_proto_messages_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardsRebalanced) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardsRebalanced) ProtoMessage() {}
+
+func (x *ShardsRebalanced) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardsRebalanced.ProtoReflect.Descriptor instead.
+func (*ShardsRebalanced) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{74}
+}
+
+type KeysHandedOff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shards []string `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+}
+
+func (x *KeysHandedOff) Reset() {
+	*x = KeysHandedOff{}
+	mi := &file_proto_messages_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeysHandedOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeysHandedOff) ProtoMessage() {}
+
+func (x *KeysHandedOff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeysHandedOff.ProtoReflect.Descriptor instead.
+func (*KeysHandedOff) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *KeysHandedOff) GetShards() []string {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+type ShardHandOff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From      string          `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Directory *EngineSnapshot `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	// Sessions and presence are not journaled, so they are left out of the journaled copy
+	Sessions []*Session  `protobuf:"bytes,3,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Presence []*Presence `protobuf:"bytes,4,rep,name=presence,proto3" json:"presence,omitempty"`
+}
+
+func (x *ShardHandOff) Reset() {
+	*x = ShardHandOff{}
+	mi := &file_proto_messages_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardHandOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardHandOff) ProtoMessage() {}
+
+func (x *ShardHandOff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardHandOff.ProtoReflect.Descriptor instead.
+func (*ShardHandOff) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ShardHandOff) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ShardHandOff) GetDirectory() *EngineSnapshot {
+	if x != nil {
+		return x.Directory
+	}
+	return nil
+}
+
+func (x *ShardHandOff) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ShardHandOff) GetPresence() []*Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_proto_messages_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Session) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Session) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Session) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ShardRouterSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shards []string `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+	Adding string   `protobuf:"bytes,2,opt,name=adding,proto3" json:"adding,omitempty"` // Shard being rebalanced to, if any
+}
+
+func (x *ShardRouterSnapshot) Reset() {
+	*x = ShardRouterSnapshot{}
+	mi := &file_proto_messages_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardRouterSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardRouterSnapshot) ProtoMessage() {}
+
+func (x *ShardRouterSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardRouterSnapshot.ProtoReflect.Descriptor instead.
+func (*ShardRouterSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ShardRouterSnapshot) GetShards() []string {
 	if x != nil {
-		return x.Author
+		return x.Shards
 	}
-	return ""
+	return nil
 }
 
-func (x *Post) GetSubredditName() string {
+func (x *ShardRouterSnapshot) GetAdding() string {
 	if x != nil {
-		return x.SubredditName
+		return x.Adding
 	}
 	return ""
 }
 
-func (x *Post) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+// Shared journal. Cluster nodes keep their grains in the engine's journal, so a grain recovers
+// on whichever node activates it; see journal.RemoteProvider. Events and snapshots are encoded
+// as google.protobuf.Any, as the BoltProvider stores them.
+type GetJournalSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorName string `protobuf:"bytes,1,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
 }
 
-func (x *Post) GetUpvotes() int32 {
-	if x != nil {
-		return x.Upvotes
-	}
-	return 0
+func (x *GetJournalSnapshot) Reset() {
+	*x = GetJournalSnapshot{}
+	mi := &file_proto_messages_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) GetDownvotes() int32 {
+func (x *GetJournalSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalSnapshot) ProtoMessage() {}
+
+func (x *GetJournalSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[79]
 	if x != nil {
-		return x.Downvotes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetPostId() string {
+// Deprecated: Use GetJournalSnapshot.ProtoReflect.Descriptor instead.
+func (*GetJournalSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetJournalSnapshot) GetActorName() string {
 	if x != nil {
-		return x.PostId
+		return x.ActorName
 	}
 	return ""
 }
 
-type CommentOnPost struct {
+type JournalSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Author  string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	PostId  string `protobuf:"bytes,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Found    bool   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Index    int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Snapshot []byte `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-func (x *CommentOnPost) Reset() {
-	*x = CommentOnPost{}
-	mi := &file_proto_messages_proto_msgTypes[19]
+func (x *JournalSnapshot) Reset() {
+	*x = JournalSnapshot{}
+	mi := &file_proto_messages_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommentOnPost) String() string {
+func (x *JournalSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentOnPost) ProtoMessage() {}
+func (*JournalSnapshot) ProtoMessage() {}
 
-func (x *CommentOnPost) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_messages_proto_msgTypes[19]
+func (x *JournalSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,57 +4935,57 @@ func (x *CommentOnPost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentOnPost.ProtoReflect.Descriptor instead.
-func (*CommentOnPost) Descriptor() ([]byte, []int) {
-	return file_proto_messages_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use JournalSnapshot.ProtoReflect.Descriptor instead.
+func (*JournalSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CommentOnPost) GetContent() string {
+func (x *JournalSnapshot) GetFound() bool {
 	if x != nil {
-		return x.Content
+		return x.Found
 	}
-	return ""
+	return false
 }
 
-func (x *CommentOnPost) GetAuthor() string {
+func (x *JournalSnapshot) GetIndex() int64 {
 	if x != nil {
-		return x.Author
+		return x.Index
 	}
-	return ""
+	return 0
 }
 
-func (x *CommentOnPost) GetPostId() string {
+func (x *JournalSnapshot) GetSnapshot() []byte {
 	if x != nil {
-		return x.PostId
+		return x.Snapshot
 	}
-	return ""
+	return nil
 }
 
-type VoteOnPost struct {
+type PersistJournalSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Upvote bool   `protobuf:"varint,2,opt,name=upvote,proto3" json:"upvote,omitempty"`
-	Voter  string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
+	ActorName string `protobuf:"bytes,1,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	Index     int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Snapshot  []byte `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-func (x *VoteOnPost) Reset() {
-	*x = VoteOnPost{}
-	mi := &file_proto_messages_proto_msgTypes[20]
+func (x *PersistJournalSnapshot) Reset() {
+	*x = PersistJournalSnapshot{}
+	mi := &file_proto_messages_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VoteOnPost) String() string {
+func (x *PersistJournalSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteOnPost) ProtoMessage() {}
+func (*PersistJournalSnapshot) ProtoMessage() {}
 
-func (x *VoteOnPost) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_messages_proto_msgTypes[20]
+func (x *PersistJournalSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,58 +4996,56 @@ func (x *VoteOnPost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteOnPost.ProtoReflect.Descriptor instead.
-func (*VoteOnPost) Descriptor() ([]byte, []int) {
-	return file_proto_messages_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PersistJournalSnapshot.ProtoReflect.Descriptor instead.
+func (*PersistJournalSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *VoteOnPost) GetPostId() string {
+func (x *PersistJournalSnapshot) GetActorName() string {
 	if x != nil {
-		return x.PostId
+		return x.ActorName
 	}
 	return ""
 }
 
-func (x *VoteOnPost) GetUpvote() bool {
+func (x *PersistJournalSnapshot) GetIndex() int64 {
 	if x != nil {
-		return x.Upvote
+		return x.Index
 	}
-	return false
+	return 0
 }
 
-func (x *VoteOnPost) GetVoter() string {
+func (x *PersistJournalSnapshot) GetSnapshot() []byte {
 	if x != nil {
-		return x.Voter
+		return x.Snapshot
 	}
-	return ""
+	return nil
 }
 
-// Comment Messages
-type CommentOnComment struct {
+type DeleteJournalSnapshots struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content         string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Author          string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	ParentCommentId string `protobuf:"bytes,3,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+	ActorName        string `protobuf:"bytes,1,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	InclusiveToIndex int64  `protobuf:"varint,2,opt,name=inclusive_to_index,json=inclusiveToIndex,proto3" json:"inclusive_to_index,omitempty"`
 }
 
-func (x *CommentOnComment) Reset() {
-	*x = CommentOnComment{}
-	mi := &file_proto_messages_proto_msgTypes[21]
+func (x *DeleteJournalSnapshots) Reset() {
+	*x = DeleteJournalSnapshots{}
+	mi := &file_proto_messages_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommentOnComment) String() string {
+func (x *DeleteJournalSnapshots) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentOnComment) ProtoMessage() {}
+func (*DeleteJournalSnapshots) ProtoMessage() {}
 
-func (x *CommentOnComment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_messages_proto_msgTypes[21]
+func (x *DeleteJournalSnapshots) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,57 +5056,50 @@ func (x *CommentOnComment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentOnComment.ProtoReflect.Descriptor instead.
-func (*CommentOnComment) Descriptor() ([]byte, []int) {
-	return file_proto_messages_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *CommentOnComment) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+// Deprecated: Use DeleteJournalSnapshots.ProtoReflect.Descriptor instead.
+func (*DeleteJournalSnapshots) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *CommentOnComment) GetAuthor() string {
+func (x *DeleteJournalSnapshots) GetActorName() string {
 	if x != nil {
-		return x.Author
+		return x.ActorName
 	}
 	return ""
 }
 
-func (x *CommentOnComment) GetParentCommentId() string {
+func (x *DeleteJournalSnapshots) GetInclusiveToIndex() int64 {
 	if x != nil {
-		return x.ParentCommentId
+		return x.InclusiveToIndex
 	}
-	return ""
+	return 0
 }
 
-type VoteOnComment struct {
+type GetJournalEvents struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentId string `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	Upvote    bool   `protobuf:"varint,2,opt,name=upvote,proto3" json:"upvote,omitempty"`
-	Voter     string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
+	ActorName string `protobuf:"bytes,1,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	Start     int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End       int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"` // Exclusive, 0 reads to the last event
 }
 
-func (x *VoteOnComment) Reset() {
-	*x = VoteOnComment{}
-	mi := &file_proto_messages_proto_msgTypes[22]
+func (x *GetJournalEvents) Reset() {
+	*x = GetJournalEvents{}
+	mi := &file_proto_messages_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VoteOnComment) String() string {
+func (x *GetJournalEvents) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteOnComment) ProtoMessage() {}
+func (*GetJournalEvents) ProtoMessage() {}
 
-func (x *VoteOnComment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_messages_proto_msgTypes[22]
+func (x *GetJournalEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,56 +5110,56 @@ func (x *VoteOnComment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteOnComment.ProtoReflect.Descriptor instead.
-func (*VoteOnComment) Descriptor() ([]byte, []int) {
-	return file_proto_messages_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetJournalEvents.ProtoReflect.Descriptor instead.
+func (*GetJournalEvents) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *VoteOnComment) GetCommentId() string {
+func (x *GetJournalEvents) GetActorName() string {
 	if x != nil {
-		return x.CommentId
+		return x.ActorName
 	}
 	return ""
 }
 
-func (x *VoteOnComment) GetUpvote() bool {
+func (x *GetJournalEvents) GetStart() int64 {
 	if x != nil {
-		return x.Upvote
+		return x.Start
 	}
-	return false
+	return 0
 }
 
-func (x *VoteOnComment) GetVoter() string {
+func (x *GetJournalEvents) GetEnd() int64 {
 	if x != nil {
-		return x.Voter
+		return x.End
 	}
-	return ""
+	return 0
 }
 
-// Feed Messages
-type GetFeed struct {
+type JournalEvents struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Events [][]byte `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	More   bool     `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"` // Set when the page is full; ask again from start plus the events returned
 }
 
-func (x *GetFeed) Reset() {
-	*x = GetFeed{}
-	mi := &file_proto_messages_proto_msgTypes[23]
+func (x *JournalEvents) Reset() {
+	*x = JournalEvents{}
+	mi := &file_proto_messages_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeed) String() string {
+func (x *JournalEvents) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeed) ProtoMessage() {}
+func (*JournalEvents) ProtoMessage() {}
 
-func (x *GetFeed) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_messages_proto_msgTypes[23]
+func (x *JournalEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,41 +5170,50 @@ func (x *GetFeed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeed.ProtoReflect.Descriptor instead.
-func (*GetFeed) Descriptor() ([]byte, []int) {
-	return file_proto_messages_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use JournalEvents.ProtoReflect.Descriptor instead.
+func (*JournalEvents) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetFeed) GetUsername() string {
+func (x *JournalEvents) GetEvents() [][]byte {
 	if x != nil {
-		return x.Username
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type Feed struct {
+func (x *JournalEvents) GetMore() bool {
+	if x != nil {
+		return x.More
+	}
+	return false
+}
+
+type PersistJournalEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	ActorName string `protobuf:"bytes,1,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	Index     int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Event     []byte `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *Feed) Reset() {
-	*x = Feed{}
-	mi := &file_proto_messages_proto_msgTypes[24]
+func (x *PersistJournalEvent) Reset() {
+	*x = PersistJournalEvent{}
+	mi := &file_proto_messages_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Feed) String() string {
+func (x *PersistJournalEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Feed) ProtoMessage() {}
+func (*PersistJournalEvent) ProtoMessage() {}
 
-func (x *Feed) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_messages_proto_msgTypes[24]
+func (x *PersistJournalEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,45 +5224,56 @@ func (x *Feed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
-func (*Feed) Descriptor() ([]byte, []int) {
-	return file_proto_messages_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PersistJournalEvent.ProtoReflect.Descriptor instead.
+func (*PersistJournalEvent) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *Feed) GetPosts() []*Post {
+func (x *PersistJournalEvent) GetActorName() string {
 	if x != nil {
-		return x.Posts
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *PersistJournalEvent) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PersistJournalEvent) GetEvent() []byte {
+	if x != nil {
+		return x.Event
 	}
 	return nil
 }
 
-// Repost Message
-type Repost struct {
+type DeleteJournalEvents struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content        string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Author         string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	OriginalPostId string `protobuf:"bytes,3,opt,name=original_post_id,json=originalPostId,proto3" json:"original_post_id,omitempty"`
-	SubredditName  string `protobuf:"bytes,4,opt,name=subreddit_name,json=subredditName,proto3" json:"subreddit_name,omitempty"`
+	ActorName        string `protobuf:"bytes,1,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	InclusiveToIndex int64  `protobuf:"varint,2,opt,name=inclusive_to_index,json=inclusiveToIndex,proto3" json:"inclusive_to_index,omitempty"`
 }
 
-func (x *Repost) Reset() {
-	*x = Repost{}
-	mi := &file_proto_messages_proto_msgTypes[25]
+func (x *DeleteJournalEvents) Reset() {
+	*x = DeleteJournalEvents{}
+	mi := &file_proto_messages_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Repost) String() string {
+func (x *DeleteJournalEvents) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repost) ProtoMessage() {}
+func (*DeleteJournalEvents) ProtoMessage() {}
 
-func (x *Repost) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_messages_proto_msgTypes[25]
+func (x *DeleteJournalEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,37 +5284,60 @@ func (x *Repost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repost.ProtoReflect.Descriptor instead.
-func (*Repost) Descriptor() ([]byte, []int) {
-	return file_proto_messages_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DeleteJournalEvents.ProtoReflect.Descriptor instead.
+func (*DeleteJournalEvents) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *Repost) GetContent() string {
+func (x *DeleteJournalEvents) GetActorName() string {
 	if x != nil {
-		return x.Content
+		return x.ActorName
 	}
 	return ""
 }
 
-func (x *Repost) GetAuthor() string {
+func (x *DeleteJournalEvents) GetInclusiveToIndex() int64 {
 	if x != nil {
-		return x.Author
+		return x.InclusiveToIndex
 	}
-	return ""
+	return 0
 }
 
-func (x *Repost) GetOriginalPostId() string {
-	if x != nil {
-		return x.OriginalPostId
-	}
-	return ""
+// Answers the requests that change the journal, once the change is stored
+type JournalAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Repost) GetSubredditName() string {
+func (x *JournalAck) Reset() {
+	*x = JournalAck{}
+	mi := &file_proto_messages_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalAck) ProtoMessage() {}
+
+func (x *JournalAck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_messages_proto_msgTypes[87]
 	if x != nil {
-		return x.SubredditName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalAck.ProtoReflect.Descriptor instead.
+func (*JournalAck) Descriptor() ([]byte, []int) {
+	return file_proto_messages_proto_rawDescGZIP(), []int{87}
 }
 
 var File_proto_messages_proto protoreflect.FileDescriptor
@@ -1489,133 +5362,593 @@ var file_proto_messages_proto_rawDesc = []byte{
 	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
 	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0x4c, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x62, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
 	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
 	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x41, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x61, 0x72, 0x6d,
-	0x61, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72,
-	0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x6c, 0x0a, 0x0d, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66,
-	0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x26, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x62, 0x6f, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x3f, 0x0a, 0x05, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65,
-	0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x22, 0x25, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x72, 0x65,
-	0x64, 0x64, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x69,
-	0x6e, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64,
-	0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x49,
-	0x44, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x50, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0d, 0x73, 0x75,
-	0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e,
-	0x50, 0x49, 0x44, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x50, 0x69,
-	0x64, 0x22, 0x53, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64,
-	0x64, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x27, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x1e, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x65, 0x65,
+	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4c, 0x0a, 0x16, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x6d, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x61,
+	0x72, 0x6d, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64,
-	0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x6f,
-	0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73,
-	0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75,
-	0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c,
+	0x6f, 0x6e, 0x65, 0x2e, 0x4b, 0x61, 0x72, 0x6d, 0x61, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x22, 0x2c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6b, 0x61, 0x72, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x4b, 0x61, 0x72, 0x6d, 0x61, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x61, 0x72, 0x6d, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x61, 0x72, 0x6d,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x61, 0x72, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6b, 0x61, 0x72, 0x6d, 0x61, 0x22, 0x73, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x6c, 0x0a, 0x0d,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5b, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x60, 0x0a, 0x05, 0x49, 0x6e, 0x62, 0x6f, 0x78,
+	0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65,
+	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x25, 0x0a, 0x0f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xdc, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64,
+	0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74,
+	0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x50,
+	0x69, 0x64, 0x12, 0x35, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f,
+	0x70, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x64, 0x64,
+	0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0c, 0x73, 0x75, 0x62,
+	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x50, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x61, 0x6e,
+	0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x66, 0x61, 0x6e, 0x6f, 0x75, 0x74, 0x4f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x22,
+	0x53, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x53,
+	0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75,
+	0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0x13, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x73, 0x22, 0x39, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x10, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xce,
-	0x01, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62,
-	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18,
-	0x0a, 0x07, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e,
-	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x6f, 0x77,
-	0x6e, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22,
-	0x5a, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x0a, 0x56,
-	0x6f, 0x74, 0x65, 0x4f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f,
-	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
-	0x22, 0x70, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x22, 0x5c, 0x0a, 0x0d, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f,
-	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
-	0x22, 0x25, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x65, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0xae, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x72,
+	0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69,
+	0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x22, 0x56, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
+	0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x0a, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63,
+	0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x64, 0x64,
+	0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75,
 	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12,
-	0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
-	0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x22, 0x60, 0x0a, 0x11, 0x46, 0x61, 0x6e, 0x6f, 0x75, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62,
+	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x0e, 0x66, 0x61, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x61, 0x6e, 0x6f, 0x75, 0x74,
+	0x4f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x72,
+	0x65, 0x64, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x64, 0x64,
+	0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x77, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0xc2, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x6f, 0x72, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f,
+	0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xf8, 0x02, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
 	0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64,
-	0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6a, 0x61, 0x73, 0x72, 0x69, 0x72, 0x61, 0x6d, 0x70,
-	0x61, 0x72, 0x76, 0x61, 0x74, 0x68, 0x61, 0x6e, 0x65, 0x6e, 0x69, 0x2f, 0x72, 0x65, 0x64, 0x64,
-	0x69, 0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64,
+	0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x17, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x72,
+	0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64,
+	0x64, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xab, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x6f, 0x77, 0x6e,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5a, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x0a, 0x56, 0x6f, 0x74,
+	0x65, 0x4f, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x73,
+	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65,
+	0x64, 0x64, 0x69, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65,
+	0x2e, 0x50, 0x49, 0x44, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x50, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x0c, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x22, 0x70, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x4f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x0d, 0x56, 0x6f, 0x74, 0x65, 0x4f,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x76, 0x6f, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22,
+	0x74, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x7a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x4d,
+	0x73, 0x22, 0xcd, 0x02, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x6f, 0x77, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x64, 0x6f, 0x77, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x32,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65,
+	0x73, 0x22, 0xda, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x64, 0x64,
+	0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e,
+	0x65, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x50, 0x69, 0x64, 0x22, 0x64,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x22, 0x2e, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x8f, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x64,
+	0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x53, 0x75, 0x62,
+	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64,
+	0x69, 0x74, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
+	0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x49, 0x44, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x50, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x0e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x52, 0x0a, 0x0f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48,
+	0x61, 0x73, 0x68, 0x22, 0x26, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x12, 0x53,
+	0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x27,
+	0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x36, 0x0a, 0x08, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x61, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x49, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c,
+	0x6f, 0x6e, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x73, 0x75, 0x62,
+	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x72,
+	0x65, 0x64, 0x64, 0x69, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x0a, 0x73, 0x75,
+	0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74,
+	0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x64,
+	0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xf5, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6b, 0x61, 0x72, 0x6d, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x4b, 0x61, 0x72, 0x6d,
+	0x61, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x61, 0x72,
+	0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x4b, 0x61, 0x72, 0x6d, 0x61, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x6e, 0x62, 0x6f, 0x78, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c,
+	0x6f, 0x6e, 0x65, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x69, 0x6e, 0x62, 0x6f, 0x78, 0x12, 0x40, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x52, 0x0d, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72,
+	0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x53, 0x75,
+	0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x34, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x4a,
+	0x6f, 0x69, 0x6e, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f,
+	0x6e, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x66, 0x61, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x61, 0x6e, 0x6f, 0x75, 0x74, 0x4f, 0x6e,
+	0x52, 0x65, 0x61, 0x64, 0x22, 0xf3, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74,
+	0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64,
+	0x65, 0x64, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65,
+	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x61, 0x73, 0x74, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x73, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x33, 0x0a,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x65, 0x64, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e,
+	0x56, 0x6f, 0x74, 0x65, 0x43, 0x61, 0x73, 0x74, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22,
+	0x11, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x22, 0x64, 0x0a, 0x16, 0x41, 0x64,
+	0x64, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73,
+	0x22, 0x66, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0x7b, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x63,
+	0x72, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x0a,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x12,
+	0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x64, 0x22, 0x27, 0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x73, 0x48, 0x61, 0x6e, 0x64, 0x65, 0x64,
+	0x4f, 0x66, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x0c,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x48, 0x61, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x39, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e,
+	0x65, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x30, 0x0a, 0x08, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a,
+	0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x22, 0x5a, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x13,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x22, 0x33, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x59, 0x0a, 0x0f, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x22, 0x69, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x65,
+	0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x54, 0x6f,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x22, 0x3b, 0x0a, 0x0d, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x22, 0x60, 0x0a,
+	0x13, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x62, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x0c, 0x0a, 0x0a, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63,
+	0x6b, 0x2a, 0x2e, 0x0a, 0x09, 0x4b, 0x61, 0x72, 0x6d, 0x61, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0e,
+	0x0a, 0x0a, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4b, 0x41, 0x52, 0x4d, 0x41, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x41, 0x52, 0x4d, 0x41, 0x10,
+	0x01, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x74, 0x65, 0x6a, 0x61, 0x73, 0x72, 0x69, 0x72, 0x61, 0x6d, 0x70, 0x61, 0x72, 0x76, 0x61, 0x74,
+	0x68, 0x61, 0x6e, 0x65, 0x6e, 0x69, 0x2f, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6c,
+	0x6f, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1630,46 +5963,143 @@ func file_proto_messages_proto_rawDescGZIP() []byte {
 	return file_proto_messages_proto_rawDescData
 }
 
-var file_proto_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_proto_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_proto_messages_proto_goTypes = []any{
-	(*PID)(nil),                    // 0: redditclone.PID
-	(*RegisterUser)(nil),           // 1: redditclone.RegisterUser
-	(*RegistrationResponse)(nil),   // 2: redditclone.RegistrationResponse
-	(*AuthenticateUser)(nil),       // 3: redditclone.AuthenticateUser
-	(*AuthenticationResponse)(nil), // 4: redditclone.AuthenticationResponse
-	(*UpdateKarma)(nil),            // 5: redditclone.UpdateKarma
-	(*SendDirectMessage)(nil),      // 6: redditclone.SendDirectMessage
-	(*DirectMessage)(nil),          // 7: redditclone.DirectMessage
-	(*GetInbox)(nil),               // 8: redditclone.GetInbox
-	(*Inbox)(nil),                  // 9: redditclone.Inbox
-	(*CreateSubreddit)(nil),        // 10: redditclone.CreateSubreddit
-	(*JoinSubreddit)(nil),          // 11: redditclone.JoinSubreddit
-	(*LeaveSubreddit)(nil),         // 12: redditclone.LeaveSubreddit
-	(*PostToSubreddit)(nil),        // 13: redditclone.PostToSubreddit
-	(*NewPostNotification)(nil),    // 14: redditclone.NewPostNotification
-	(*GetSubredditPosts)(nil),      // 15: redditclone.GetSubredditPosts
-	(*SubredditPosts)(nil),         // 16: redditclone.SubredditPosts
-	(*GetPostDetails)(nil),         // 17: redditclone.GetPostDetails
-	(*Post)(nil),                   // 18: redditclone.Post
-	(*CommentOnPost)(nil),          // 19: redditclone.CommentOnPost
-	(*VoteOnPost)(nil),             // 20: redditclone.VoteOnPost
-	(*CommentOnComment)(nil),       // 21: redditclone.CommentOnComment
-	(*VoteOnComment)(nil),          // 22: redditclone.VoteOnComment
-	(*GetFeed)(nil),                // 23: redditclone.GetFeed
-	(*Feed)(nil),                   // 24: redditclone.Feed
-	(*Repost)(nil),                 // 25: redditclone.Repost
+	(KarmaKind)(0),                  // 0: redditclone.KarmaKind
+	(*PID)(nil),                     // 1: redditclone.PID
+	(*RegisterUser)(nil),            // 2: redditclone.RegisterUser
+	(*RegistrationResponse)(nil),    // 3: redditclone.RegistrationResponse
+	(*AuthenticateUser)(nil),        // 4: redditclone.AuthenticateUser
+	(*AuthenticationResponse)(nil),  // 5: redditclone.AuthenticationResponse
+	(*ValidateSession)(nil),         // 6: redditclone.ValidateSession
+	(*SessionInfo)(nil),             // 7: redditclone.SessionInfo
+	(*Logout)(nil),                  // 8: redditclone.Logout
+	(*ChangePassword)(nil),          // 9: redditclone.ChangePassword
+	(*PasswordChangeResponse)(nil),  // 10: redditclone.PasswordChangeResponse
+	(*UpdateKarma)(nil),             // 11: redditclone.UpdateKarma
+	(*GetUserProfile)(nil),          // 12: redditclone.GetUserProfile
+	(*UserProfile)(nil),             // 13: redditclone.UserProfile
+	(*SendDirectMessage)(nil),       // 14: redditclone.SendDirectMessage
+	(*DirectMessage)(nil),           // 15: redditclone.DirectMessage
+	(*GetInbox)(nil),                // 16: redditclone.GetInbox
+	(*Inbox)(nil),                   // 17: redditclone.Inbox
+	(*CreateSubreddit)(nil),         // 18: redditclone.CreateSubreddit
+	(*JoinSubreddit)(nil),           // 19: redditclone.JoinSubreddit
+	(*LeaveSubreddit)(nil),          // 20: redditclone.LeaveSubreddit
+	(*PostToSubreddit)(nil),         // 21: redditclone.PostToSubreddit
+	(*PostResponse)(nil),            // 22: redditclone.PostResponse
+	(*NewPostNotification)(nil),     // 23: redditclone.NewPostNotification
+	(*Connect)(nil),                 // 24: redditclone.Connect
+	(*Disconnect)(nil),              // 25: redditclone.Disconnect
+	(*Notification)(nil),            // 26: redditclone.Notification
+	(*GetPresence)(nil),             // 27: redditclone.GetPresence
+	(*Presence)(nil),                // 28: redditclone.Presence
+	(*FanoutModeChanged)(nil),       // 29: redditclone.FanoutModeChanged
+	(*GetSubredditPosts)(nil),       // 30: redditclone.GetSubredditPosts
+	(*SubredditPosts)(nil),          // 31: redditclone.SubredditPosts
+	(*GetPostDetails)(nil),          // 32: redditclone.GetPostDetails
+	(*GetPostWithComments)(nil),     // 33: redditclone.GetPostWithComments
+	(*PostWithComments)(nil),        // 34: redditclone.PostWithComments
+	(*Post)(nil),                    // 35: redditclone.Post
+	(*PostScoreChanged)(nil),        // 36: redditclone.PostScoreChanged
+	(*CommentOnPost)(nil),           // 37: redditclone.CommentOnPost
+	(*VoteOnPost)(nil),              // 38: redditclone.VoteOnPost
+	(*PostCreated)(nil),             // 39: redditclone.PostCreated
+	(*Registered)(nil),              // 40: redditclone.Registered
+	(*CommentOnComment)(nil),        // 41: redditclone.CommentOnComment
+	(*VoteOnComment)(nil),           // 42: redditclone.VoteOnComment
+	(*GetComment)(nil),              // 43: redditclone.GetComment
+	(*GetCommentTree)(nil),          // 44: redditclone.GetCommentTree
+	(*CommentNode)(nil),             // 45: redditclone.CommentNode
+	(*CommentCreated)(nil),          // 46: redditclone.CommentCreated
+	(*CommentResponse)(nil),         // 47: redditclone.CommentResponse
+	(*VoteResponse)(nil),            // 48: redditclone.VoteResponse
+	(*NotFound)(nil),                // 49: redditclone.NotFound
+	(*GetFeed)(nil),                 // 50: redditclone.GetFeed
+	(*Feed)(nil),                    // 51: redditclone.Feed
+	(*Repost)(nil),                  // 52: redditclone.Repost
+	(*UserRegistered)(nil),          // 53: redditclone.UserRegistered
+	(*PasswordChanged)(nil),         // 54: redditclone.PasswordChanged
+	(*SubredditCreated)(nil),        // 55: redditclone.SubredditCreated
+	(*SubredditPostAdded)(nil),      // 56: redditclone.SubredditPostAdded
+	(*TimelinePostsAdded)(nil),      // 57: redditclone.TimelinePostsAdded
+	(*CommentAdded)(nil),            // 58: redditclone.CommentAdded
+	(*VoteCast)(nil),                // 59: redditclone.VoteCast
+	(*Reposted)(nil),                // 60: redditclone.Reposted
+	(*EngineSnapshot)(nil),          // 61: redditclone.EngineSnapshot
+	(*UserSnapshot)(nil),            // 62: redditclone.UserSnapshot
+	(*SubredditSnapshot)(nil),       // 63: redditclone.SubredditSnapshot
+	(*PostSnapshot)(nil),            // 64: redditclone.PostSnapshot
+	(*CommentSnapshot)(nil),         // 65: redditclone.CommentSnapshot
+	(*CompactJournals)(nil),         // 66: redditclone.CompactJournals
+	(*CompactJournalsResponse)(nil), // 67: redditclone.CompactJournalsResponse
+	(*GetRecoveryStats)(nil),        // 68: redditclone.GetRecoveryStats
+	(*AddEngineShard)(nil),          // 69: redditclone.AddEngineShard
+	(*AddEngineShardResponse)(nil),  // 70: redditclone.AddEngineShardResponse
+	(*RecoveryStats)(nil),           // 71: redditclone.RecoveryStats
+	(*ActorRecovery)(nil),           // 72: redditclone.ActorRecovery
+	(*ShardsCreated)(nil),           // 73: redditclone.ShardsCreated
+	(*ShardAdded)(nil),              // 74: redditclone.ShardAdded
+	(*ShardsRebalanced)(nil),        // 75: redditclone.ShardsRebalanced
+	(*KeysHandedOff)(nil),           // 76: redditclone.KeysHandedOff
+	(*ShardHandOff)(nil),            // 77: redditclone.ShardHandOff
+	(*Session)(nil),                 // 78: redditclone.Session
+	(*ShardRouterSnapshot)(nil),     // 79: redditclone.ShardRouterSnapshot
+	(*GetJournalSnapshot)(nil),      // 80: redditclone.GetJournalSnapshot
+	(*JournalSnapshot)(nil),         // 81: redditclone.JournalSnapshot
+	(*PersistJournalSnapshot)(nil),  // 82: redditclone.PersistJournalSnapshot
+	(*DeleteJournalSnapshots)(nil),  // 83: redditclone.DeleteJournalSnapshots
+	(*GetJournalEvents)(nil),        // 84: redditclone.GetJournalEvents
+	(*JournalEvents)(nil),           // 85: redditclone.JournalEvents
+	(*PersistJournalEvent)(nil),     // 86: redditclone.PersistJournalEvent
+	(*DeleteJournalEvents)(nil),     // 87: redditclone.DeleteJournalEvents
+	(*JournalAck)(nil),              // 88: redditclone.JournalAck
 }
 var file_proto_messages_proto_depIdxs = []int32{
-	7,  // 0: redditclone.Inbox.messages:type_name -> redditclone.DirectMessage
-	0,  // 1: redditclone.JoinSubreddit.user_pid:type_name -> redditclone.PID
-	0,  // 2: redditclone.JoinSubreddit.subreddit_pid:type_name -> redditclone.PID
-	18, // 3: redditclone.SubredditPosts.posts:type_name -> redditclone.Post
-	18, // 4: redditclone.Feed.posts:type_name -> redditclone.Post
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	0,  // 0: redditclone.UpdateKarma.kind:type_name -> redditclone.KarmaKind
+	15, // 1: redditclone.Inbox.messages:type_name -> redditclone.DirectMessage
+	1,  // 2: redditclone.JoinSubreddit.user_pid:type_name -> redditclone.PID
+	1,  // 3: redditclone.JoinSubreddit.subreddit_pid:type_name -> redditclone.PID
+	35, // 4: redditclone.NewPostNotification.post:type_name -> redditclone.Post
+	1,  // 5: redditclone.Connect.client_pid:type_name -> redditclone.PID
+	23, // 6: redditclone.Notification.new_post:type_name -> redditclone.NewPostNotification
+	15, // 7: redditclone.Notification.direct_message:type_name -> redditclone.DirectMessage
+	35, // 8: redditclone.SubredditPosts.posts:type_name -> redditclone.Post
+	35, // 9: redditclone.PostWithComments.post:type_name -> redditclone.Post
+	45, // 10: redditclone.PostWithComments.comments:type_name -> redditclone.CommentNode
+	1,  // 11: redditclone.PostCreated.post_pid:type_name -> redditclone.PID
+	45, // 12: redditclone.CommentNode.replies:type_name -> redditclone.CommentNode
+	1,  // 13: redditclone.CommentCreated.comment_pid:type_name -> redditclone.PID
+	1,  // 14: redditclone.CommentCreated.post_pid:type_name -> redditclone.PID
+	35, // 15: redditclone.Feed.posts:type_name -> redditclone.Post
+	1,  // 16: redditclone.Repost.subreddit_pid:type_name -> redditclone.PID
+	35, // 17: redditclone.SubredditPostAdded.post:type_name -> redditclone.Post
+	35, // 18: redditclone.TimelinePostsAdded.posts:type_name -> redditclone.Post
+	53, // 19: redditclone.EngineSnapshot.users:type_name -> redditclone.UserRegistered
+	55, // 20: redditclone.EngineSnapshot.subreddits:type_name -> redditclone.SubredditCreated
+	39, // 21: redditclone.EngineSnapshot.posts:type_name -> redditclone.PostCreated
+	46, // 22: redditclone.EngineSnapshot.comments:type_name -> redditclone.CommentCreated
+	15, // 23: redditclone.UserSnapshot.inbox:type_name -> redditclone.DirectMessage
+	19, // 24: redditclone.UserSnapshot.subscriptions:type_name -> redditclone.JoinSubreddit
+	35, // 25: redditclone.UserSnapshot.timeline:type_name -> redditclone.Post
+	19, // 26: redditclone.SubredditSnapshot.members:type_name -> redditclone.JoinSubreddit
+	35, // 27: redditclone.SubredditSnapshot.posts:type_name -> redditclone.Post
+	58, // 28: redditclone.PostSnapshot.comments:type_name -> redditclone.CommentAdded
+	46, // 29: redditclone.PostSnapshot.replies:type_name -> redditclone.CommentCreated
+	59, // 30: redditclone.PostSnapshot.votes:type_name -> redditclone.VoteCast
+	35, // 31: redditclone.PostSnapshot.post:type_name -> redditclone.Post
+	58, // 32: redditclone.CommentSnapshot.replies:type_name -> redditclone.CommentAdded
+	59, // 33: redditclone.CommentSnapshot.votes:type_name -> redditclone.VoteCast
+	72, // 34: redditclone.RecoveryStats.actors:type_name -> redditclone.ActorRecovery
+	61, // 35: redditclone.ShardHandOff.directory:type_name -> redditclone.EngineSnapshot
+	78, // 36: redditclone.ShardHandOff.sessions:type_name -> redditclone.Session
+	28, // 37: redditclone.ShardHandOff.presence:type_name -> redditclone.Presence
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_proto_messages_proto_init() }
@@ -1682,13 +6112,14 @@ func file_proto_messages_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_messages_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   26,
+			NumEnums:      1,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_messages_proto_goTypes,
 		DependencyIndexes: file_proto_messages_proto_depIdxs,
+		EnumInfos:         file_proto_messages_proto_enumTypes,
 		MessageInfos:      file_proto_messages_proto_msgTypes,
 	}.Build()
 	File_proto_messages_proto = out.File
@@ -0,0 +1,40 @@
+package proto
+
+import (
+	"fmt"
+
+	"github.com/asynkron/protoactor-go/remote"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// RegisterRemoteMessages checks that protoactor remote can carry every message declared in
+// messages.proto. Remote resolves incoming type names through the global registry and panics
+// on a name it cannot find, so each process calls this before it starts remoting rather than
+// discovering a missing type on the first cross-process message. It returns how many message
+// types were checked.
+func RegisterRemoteMessages() (int, error) {
+	messages := File_proto_messages_proto.Messages()
+	for i := 0; i < messages.Len(); i++ {
+		if err := registerRemoteMessage(messages.Get(i)); err != nil {
+			return i, err
+		}
+	}
+	return messages.Len(), nil
+}
+
+func registerRemoteMessage(descriptor protoreflect.MessageDescriptor) error {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(descriptor.FullName())
+	if err != nil {
+		return fmt.Errorf("%s is not registered: %w", descriptor.FullName(), err)
+	}
+
+	data, typeName, err := remote.Serialize(messageType.New().Interface(), remote.DefaultSerializerID)
+	if err != nil {
+		return fmt.Errorf("serializing %s: %w", descriptor.FullName(), err)
+	}
+	if _, err := remote.Deserialize(data, typeName, remote.DefaultSerializerID); err != nil {
+		return fmt.Errorf("deserializing %s: %w", typeName, err)
+	}
+	return nil
+}
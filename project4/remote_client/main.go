@@ -0,0 +1,148 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/remote"
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+)
+
+const requestTimeout = 5 * time.Second
+
+// The remote client is a user in its own process talking to the engine over remoting. It
+// registers, connects a client actor the engine pushes notifications to, and joins a
+// subreddit; then it either posts and messages a peer, or waits for the peer to do so:
+//
+//	remote_client -user alice -subreddit go -expect 3
+//	remote_client -user bob -subreddit go -posts 2 -message alice
+//
+// It exits non-zero when a request fails or the expected notifications do not arrive.
+func main() {
+	engineAddress := flag.String("engine", "127.0.0.1:8080", "Remote address of the engine")
+	username := flag.String("user", "", "User to act as, registered if needed")
+	subreddit := flag.String("subreddit", "remote", "Subreddit to create and join")
+	posts := flag.Int("posts", 0, "Posts to make in the subreddit")
+	recipient := flag.String("message", "", "User to send a direct message to")
+	expect := flag.Int("expect", 0, "Notifications to wait for before exiting")
+	timeout := flag.Duration("timeout", 10*time.Second, "How long to wait for the expected notifications")
+	flag.Parse()
+
+	if *username == "" {
+		fmt.Println("Usage: remote_client -user name [-engine host:port] [-subreddit name] [-posts n] [-message user] [-expect n]")
+		os.Exit(2)
+	}
+	if _, err := proto.RegisterRemoteMessages(); err != nil {
+		fail("Failed to register messages for remoting: %v", err)
+	}
+
+	system := actor.NewActorSystem()
+	remoting := remote.NewRemote(system, remote.Configure("127.0.0.1", 0))
+	remoting.Start()
+	defer remoting.Shutdown(true)
+
+	client := &remoteClient{
+		system:        system,
+		enginePID:     actor.NewPID(*engineAddress, "engine"),
+		username:      *username,
+		notifications: make(chan *proto.Notification, 100),
+	}
+	client.join(*subreddit)
+	fmt.Printf("%s is connected as %v and joined %s\n", client.username, client.clientPID, *subreddit)
+
+	for i := 1; i <= *posts; i++ {
+		client.post(*subreddit, fmt.Sprintf("Post %d from %s", i, client.username))
+	}
+	if *recipient != "" {
+		client.system.Root.Send(client.enginePID, &proto.SendDirectMessage{
+			FromUsername: client.username,
+			ToUsername:   *recipient,
+			Content:      "Hello from " + client.username,
+		})
+		// Messages are not acknowledged; answering this request means the engine has it
+		if presence, ok := client.request(&proto.GetPresence{Username: *recipient}).(*proto.Presence); ok {
+			fmt.Printf("%s sent a direct message to %s (online: %v)\n", client.username, *recipient, presence.Online)
+		}
+	}
+
+	client.await(*expect, *timeout)
+}
+
+type remoteClient struct {
+	system        *actor.ActorSystem
+	enginePID     *actor.PID
+	username      string
+	clientPID     *actor.PID
+	notifications chan *proto.Notification
+}
+
+// join registers the user, connects a client actor in this process and joins subreddit
+func (client *remoteClient) join(subreddit string) {
+	res := client.request(&proto.RegisterUser{Username: client.username, Password: "password"})
+	if response, ok := res.(*proto.RegistrationResponse); !ok {
+		fail("Unexpected registration response %v", res)
+	} else if !response.Success {
+		fmt.Printf("%s: %s\n", client.username, response.Message)
+	}
+
+	client.clientPID = client.system.Root.Spawn(actor.PropsFromFunc(func(context actor.Context) {
+		if notification, ok := context.Message().(*proto.Notification); ok {
+			client.notifications <- notification
+		}
+	}))
+	client.request(&proto.Connect{
+		Username:  client.username,
+		ClientPid: &proto.PID{Address: client.clientPID.Address, Id: client.clientPID.Id},
+	})
+
+	// Neither is acknowledged; the listing request queues behind both
+	client.system.Root.Send(client.enginePID, &proto.CreateSubreddit{Name: subreddit})
+	client.system.Root.Send(client.enginePID, &proto.JoinSubreddit{Username: client.username, SubredditName: subreddit})
+	client.request(&proto.GetSubredditPosts{SubredditName: subreddit, PageSize: 1})
+}
+
+func (client *remoteClient) post(subreddit, content string) {
+	res := client.request(&proto.PostToSubreddit{Content: content, Author: client.username, SubredditName: subreddit})
+	response, ok := res.(*proto.PostResponse)
+	if !ok || !response.Success {
+		fail("Posting to %s failed: %v", subreddit, res)
+	}
+	fmt.Printf("%s posted %s\n", client.username, response.PostId)
+}
+
+// await prints notifications until expect of them arrived, failing once timeout passes
+func (client *remoteClient) await(expect int, timeout time.Duration) {
+	deadline := time.After(timeout)
+	for received := 0; received < expect; received++ {
+		select {
+		case notification := <-client.notifications:
+			switch {
+			case notification.NewPost != nil:
+				fmt.Printf("%s was notified of %s by %s\n", client.username, notification.NewPost.PostId, notification.NewPost.Author)
+			case notification.DirectMessage != nil:
+				fmt.Printf("%s received a direct message from %s\n", client.username, notification.DirectMessage.FromUsername)
+			}
+		case <-deadline:
+			fail("%s received %d of %d notifications within %v", client.username, received, expect, timeout)
+		}
+	}
+}
+
+func (client *remoteClient) request(msg interface{}) interface{} {
+	res, err := client.system.Root.RequestFuture(client.enginePID, msg, requestTimeout).Result()
+	if err != nil {
+		fail("Engine did not respond to %T: %v", msg, err)
+	}
+	if notFound, ok := res.(*proto.NotFound); ok {
+		fail("%s %s not found", notFound.Kind, notFound.Id)
+	}
+	return res
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Printf(format+"\n", args...)
+	os.Exit(1)
+}
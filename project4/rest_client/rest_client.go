@@ -14,59 +14,77 @@ func RunClient() {
 		"username": "TEJA",
 		"password": "password123",
 	}
-	doPost("http://localhost:3000/users", registerReq)
+	doPost("http://localhost:3000/users", "", registerReq)
+
+	// Log in and use the session token for everything else
+	token := login("TEJA", "password123")
 
 	// Create a subreddit
 	subredditReq := map[string]string{
 		"name": "golang",
 	}
-	doPost("http://localhost:3000/subreddits", subredditReq)
+	doPost("http://localhost:3000/subreddits", token, subredditReq)
 
 	// Join the subreddit
-	joinReq := map[string]string{"username": "TEJA"}
-	doPost("http://localhost:3000/subreddits/golang/join", joinReq)
+	doPost("http://localhost:3000/subreddits/golang/join", token, nil)
 
 	// Post to the subreddit
 	postReq := map[string]string{
-		"author":  "TEJA",
 		"content": "Hello Gators!",
 	}
-	doPost("http://localhost:3000/subreddits/golang/posts", postReq)
+	doPost("http://localhost:3000/subreddits/golang/posts", token, postReq)
 
 	// Get feed
-	doGet("http://localhost:3000/users/TEJA/feed")
+	doGet("http://localhost:3000/users/TEJA/feed", "")
 
 	// Send a direct message
 	msgReq := map[string]string{
-		"from_username": "TEJA",
-		"to_username":   "SATWIK",
-		"content":       "Hey SATHWIK!",
+		"to_username": "SATWIK",
+		"content":     "Hey SATHWIK!",
 	}
-	doPost("http://localhost:3000/messages", msgReq)
+	doPost("http://localhost:3000/messages", token, msgReq)
 
 	// Get inbox
-	doGet("http://localhost:3000/users/TEJA/inbox")
+	doGet("http://localhost:3000/users/TEJA/inbox", token)
 }
 
-func doPost(url string, data interface{}) {
-	jsonData, _ := json.Marshal(data)
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer(jsonData))
-	if err != nil {
-		fmt.Printf("POST %s error: %v\n", url, err)
-		return
+func login(username, password string) string {
+	loginReq := map[string]string{
+		"username": username,
+		"password": password,
 	}
-	defer resp.Body.Close()
-	body, _ := ioutil.ReadAll(resp.Body)
-	fmt.Printf("POST %s -> %s\n", url, string(body))
+	body := doPost("http://localhost:3000/login", "", loginReq)
+
+	var resp struct {
+		Token string `json:"token"`
+	}
+	json.Unmarshal(body, &resp)
+	return resp.Token
 }
 
-func doGet(url string) {
-	resp, err := http.Get(url)
+func doPost(url, token string, data interface{}) []byte {
+	jsonData, _ := json.Marshal(data)
+	req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonData))
+	req.Header.Set("Content-Type", "application/json")
+	return doRequest(req, token)
+}
+
+func doGet(url, token string) []byte {
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	return doRequest(req, token)
+}
+
+func doRequest(req *http.Request, token string) []byte {
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		fmt.Printf("GET %s error: %v\n", url, err)
-		return
+		fmt.Printf("%s %s error: %v\n", req.Method, req.URL, err)
+		return nil
 	}
 	defer resp.Body.Close()
 	body, _ := ioutil.ReadAll(resp.Body)
-	fmt.Printf("GET %s -> %s\n", url, string(body))
+	fmt.Printf("%s %s -> %s\n", req.Method, req.URL, string(body))
+	return body
 }
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+	"github.com/tejasriramparvathaneni/reddit_clone/utils"
+)
+
+const usernameKey = "username"
+
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func loginHandler(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Username == "" || req.Password == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid login data"})
+		return
+	}
+
+	future := system.Root.RequestFuture(enginePID, &proto.AuthenticateUser{
+		Username: req.Username,
+		Password: req.Password,
+	}, 5*time.Second)
+
+	result, err := future.Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine timeout or error"})
+		return
+	}
+
+	switch resp := result.(type) {
+	case *proto.AuthenticationResponse:
+		if !resp.Success {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": resp.Message})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": resp.Message, "token": resp.Token})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
+func logoutHandler(c *gin.Context) {
+	future := system.Root.RequestFuture(enginePID, &proto.Logout{
+		Token: bearerToken(c),
+	}, 5*time.Second)
+
+	result, err := future.Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine timeout or error"})
+		return
+	}
+
+	switch resp := result.(type) {
+	case *proto.AuthenticationResponse:
+		c.JSON(http.StatusOK, gin.H{"message": resp.Message})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
+func changePasswordHandler(c *gin.Context) {
+	username := c.Param("username")
+	if username != currentUser(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Cannot change another user's password"})
+		return
+	}
+
+	var req struct {
+		OldPassword string `json:"old_password"`
+		NewPassword string `json:"new_password"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil || req.OldPassword == "" || req.NewPassword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid password data"})
+		return
+	}
+	if err := utils.ValidatePassword(req.NewPassword); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid password: " + err.Error()})
+		return
+	}
+
+	future := system.Root.RequestFuture(enginePID, &proto.ChangePassword{
+		Username:    username,
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+		KeepToken:   bearerToken(c),
+	}, 5*time.Second)
+
+	result, err := future.Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine timeout or error"})
+		return
+	}
+
+	switch resp := result.(type) {
+	case *proto.PasswordChangeResponse:
+		if !resp.Success {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": resp.Message})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": resp.Message})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
+// requireSession resolves the bearer token to a username through the engine.
+// Handlers behind it must take the acting user from currentUser, never from the request body.
+func requireSession(c *gin.Context) {
+	token := bearerToken(c)
+	if token == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing bearer token"})
+		return
+	}
+
+	future := system.Root.RequestFuture(enginePID, &proto.ValidateSession{Token: token}, 5*time.Second)
+	result, err := future.Result()
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Engine timeout or error"})
+		return
+	}
+
+	session, ok := result.(*proto.SessionInfo)
+	if !ok {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+		return
+	}
+	if !session.Valid {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired session"})
+		return
+	}
+
+	c.Set(usernameKey, session.Username)
+	c.Next()
+}
+
+func currentUser(c *gin.Context) string {
+	return c.GetString(usernameKey)
+}
+
+func bearerToken(c *gin.Context) string {
+	header := c.GetHeader("Authorization")
+	token, found := strings.CutPrefix(header, "Bearer ")
+	if !found {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
@@ -1,10 +1,63 @@
 package main
 
 import (
+	"flag"
 	"log"
+
+	"github.com/tejasriramparvathaneni/reddit_clone/actors"
+	"github.com/tejasriramparvathaneni/reddit_clone/journal"
+	"github.com/tejasriramparvathaneni/reddit_clone/storage"
 )
 
 func main() {
-	log.Println("Starting REST server on port 3000...")
-	StartServer()
+	config := ServerConfig{}
+	flag.StringVar(&config.Addr, "addr", ":3000", "Address the REST API listens on")
+	flag.StringVar(&config.EngineAddress, "engine", "", "host:port of a remote engine process, e.g. 127.0.0.1:8080; runs the engine in-process when empty")
+	flag.StringVar(&config.RemoteHost, "remote-host", "127.0.0.1", "Host the engine sends replies to")
+	flag.IntVar(&config.RemotePort, "remote-port", 8081, "Port the engine sends replies to, 0 picks a free one")
+	journalPath := flag.String("journal", "", "BoltDB file the engine is persisted to; kept in memory when empty")
+	snapshotIntervals := flag.String("snapshot-intervals", "", "Events between snapshots per actor kind, e.g. post=100,comment=100")
+	storageBackend := flag.String("storage", "", "Storage backend: memory, bolt or sqlite; none by default")
+	storagePath := flag.String("storage-path", "reddit.db", "Database file for the bolt and sqlite storage backends")
+	shards := flag.Int("shards", 0, "Engine shards behind a router, 0 runs a single engine")
+	flag.Parse()
+
+	// The engine flags below belong to the engine process when it is remote
+	if config.EngineAddress != "" {
+		log.Printf("Starting REST server on %s for engine %s...\n", config.Addr, config.EngineAddress)
+		StartServer(config)
+		return
+	}
+
+	intervals, err := actors.ParseSnapshotIntervals(*snapshotIntervals)
+	if err != nil {
+		log.Fatalf("Invalid -snapshot-intervals: %v\n", err)
+	}
+	var opts []actors.EngineOption
+	for kind, interval := range intervals {
+		opts = append(opts, actors.WithSnapshotInterval(kind, interval))
+	}
+
+	if *storageBackend != "" {
+		repository, err := storage.Open(*storageBackend, *storagePath)
+		if err != nil {
+			log.Fatalf("Failed to open %s storage: %v\n", *storageBackend, err)
+		}
+		defer repository.Close()
+		opts = append(opts, actors.WithRepository(repository))
+	}
+	if *shards > 0 {
+		opts = append(opts, actors.WithShards(*shards))
+	}
+	if *journalPath != "" {
+		provider, err := journal.NewBoltProvider(*journalPath, journal.DefaultSnapshotInterval)
+		if err != nil {
+			log.Fatalf("Failed to open journal %s: %v\n", *journalPath, err)
+		}
+		defer provider.Close()
+		opts = append(opts, actors.WithJournal(provider))
+	}
+
+	log.Printf("Starting REST server on %s...\n", config.Addr)
+	StartServer(config, opts...)
 }
@@ -4,13 +4,17 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/remote"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/tejasriramparvathaneni/reddit_clone/actors"
 	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+	"github.com/tejasriramparvathaneni/reddit_clone/utils"
 )
 
 var (
@@ -18,48 +22,104 @@ var (
 	enginePID *actor.PID
 )
 
-func StartServer() {
+// engineAttempts bounds how long startup waits for a remote engine to answer
+const engineAttempts = 10
+
+// ServerConfig says where the REST server listens and which engine serves its requests
+type ServerConfig struct {
+	Addr string
+	// Host and port this process receives remote replies on; port 0 picks a free one, so
+	// several front-ends can run side by side
+	RemoteHost string
+	RemotePort int
+	// host:port of an engine process; empty runs an engine in this process with the options
+	// passed to StartServer
+	EngineAddress string
+}
+
+func StartServer(config ServerConfig, opts ...actors.EngineOption) {
+	if _, err := proto.RegisterRemoteMessages(); err != nil {
+		log.Fatalf("Failed to register messages for remoting: %v\n", err)
+	}
 	system = actor.NewActorSystem()
-	// Use a different port than engine to avoid conflicts, e.g. 8081
-	remoteConfig := remote.Configure("127.0.0.1", 8081)
+	remoteConfig := remote.Configure(config.RemoteHost, config.RemotePort)
 	remoting := remote.NewRemote(system, remoteConfig)
 	remoting.Start()
 
-	engineProps := actor.PropsFromProducer(func() actor.Actor {
-		return actors.NewEngineActor()
-	})
 	var err error
-	enginePID, err = system.Root.SpawnNamed(engineProps, "engine")
-	if err != nil {
-		log.Fatalf("Failed to spawn engine actor: %v\n", err)
+	if config.EngineAddress != "" {
+		enginePID, err = resolveEngine(config.EngineAddress)
+		if err != nil {
+			log.Fatalf("Failed to reach engine: %v\n", err)
+		}
+		fmt.Printf("Using remote engine %v\n", enginePID)
+	} else {
+		opts = append(opts, actors.WithMetrics(actors.NewMetrics(prometheus.DefaultRegisterer)))
+		enginePID, err = system.Root.SpawnNamed(actors.NewEngineProps(opts...), "engine")
+		if err != nil {
+			log.Fatalf("Failed to spawn engine actor: %v\n", err)
+		}
+		fmt.Println("Engine is running...")
+		fmt.Printf("Engine PID: %v\n", enginePID)
 	}
 
-	fmt.Println("Engine is running...")
-	fmt.Printf("Engine PID: %v\n", enginePID)
-
 	r := gin.Default()
+	r.Use(observeRequests)
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
 	// Define all required routes
 	r.POST("/users", registerUserHandler)
-	r.GET("/users/:username/inbox", getInboxHandler)
+	r.POST("/login", loginHandler)
+	r.GET("/users/:username", getUserProfileHandler)
 	r.GET("/users/:username/feed", getFeedHandler)
+	r.GET("/subreddits/:name/posts", getSubredditPostsHandler)
+	r.GET("/posts/:post_id", getPostHandler)
+	r.GET("/comments/:comment_id", getCommentHandler)
 
-	r.POST("/subreddits", createSubredditHandler)
-	r.POST("/subreddits/:name/join", joinSubredditHandler)
-	r.POST("/subreddits/:name/leave", leaveSubredditHandler)
-	r.POST("/subreddits/:name/posts", postToSubredditHandler)
+	// Routes below act on behalf of the user owning the bearer token
+	auth := r.Group("/", requireSession)
+	auth.POST("/logout", logoutHandler)
+	auth.GET("/users/:username/inbox", getInboxHandler)
+	auth.PUT("/users/:username/password", changePasswordHandler)
 
-	r.POST("/posts/:post_id/comments", commentOnPostHandler)
-	r.POST("/posts/:post_id/votes", voteOnPostHandler)
+	auth.POST("/subreddits", createSubredditHandler)
+	auth.POST("/subreddits/:name/join", joinSubredditHandler)
+	auth.POST("/subreddits/:name/leave", leaveSubredditHandler)
+	auth.POST("/subreddits/:name/posts", postToSubredditHandler)
 
-	r.POST("/messages", sendDirectMessageHandler)
+	auth.POST("/posts/:post_id/comments", commentOnPostHandler)
+	auth.POST("/posts/:post_id/votes", voteOnPostHandler)
+	auth.DELETE("/posts/:post_id/votes", retractPostVoteHandler)
+	auth.POST("/posts/:post_id/reposts", repostHandler)
 
-	err = r.Run(":3000")
+	auth.POST("/comments/:comment_id/replies", replyToCommentHandler)
+	auth.POST("/comments/:comment_id/votes", voteOnCommentHandler)
+	auth.DELETE("/comments/:comment_id/votes", retractCommentVoteHandler)
+
+	auth.POST("/messages", sendDirectMessageHandler)
+
+	err = r.Run(config.Addr)
 	if err != nil {
 		fmt.Println("Failed to start server:", err)
 	}
 }
 
+// resolveEngine returns the PID of the engine process at address once it answers a Touch
+func resolveEngine(address string) (*actor.PID, error) {
+	pid := actor.NewPID(address, "engine")
+	for attempt := 1; ; attempt++ {
+		_, err := system.Root.RequestFuture(pid, &actor.Touch{}, 2*time.Second).Result()
+		if err == nil {
+			return pid, nil
+		}
+		if attempt == engineAttempts {
+			return nil, fmt.Errorf("engine at %s did not answer after %d attempts: %w", address, attempt, err)
+		}
+		log.Printf("Waiting for engine at %s: %v\n", address, err)
+		time.Sleep(time.Second)
+	}
+}
+
 type RegisterUserRequest struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
@@ -71,6 +131,11 @@ func registerUserHandler(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	// Checked here so that a registration the engine turns down can only be a taken username
+	if err := utils.ValidatePassword(req.Password); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid password: " + err.Error()})
+		return
+	}
 
 	future := system.Root.RequestFuture(enginePID, &proto.RegisterUser{
 		Username: req.Username,
@@ -83,13 +148,16 @@ func registerUserHandler(c *gin.Context) {
 		return
 	}
 
-	resp := result.(*proto.RegistrationResponse)
-	if !resp.Success {
-		c.JSON(http.StatusConflict, gin.H{"message": resp.Message})
-		return
+	switch resp := result.(type) {
+	case *proto.RegistrationResponse:
+		if !resp.Success {
+			c.JSON(http.StatusConflict, gin.H{"message": resp.Message})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": resp.Message})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
 	}
-
-	c.JSON(http.StatusOK, gin.H{"message": resp.Message})
 }
 
 func createSubredditHandler(c *gin.Context) {
@@ -107,16 +175,8 @@ func createSubredditHandler(c *gin.Context) {
 
 func joinSubredditHandler(c *gin.Context) {
 	subredditName := c.Param("name")
-	var req struct {
-		Username string `json:"username"`
-	}
-	if err := c.ShouldBindJSON(&req); err != nil || req.Username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid username"})
-		return
-	}
-
 	system.Root.Send(enginePID, &proto.JoinSubreddit{
-		Username:      req.Username,
+		Username:      currentUser(c),
 		SubredditName: subredditName,
 	})
 
@@ -125,16 +185,8 @@ func joinSubredditHandler(c *gin.Context) {
 
 func leaveSubredditHandler(c *gin.Context) {
 	subredditName := c.Param("name")
-	var req struct {
-		Username string `json:"username"`
-	}
-	if err := c.ShouldBindJSON(&req); err != nil || req.Username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid username"})
-		return
-	}
-
 	system.Root.Send(enginePID, &proto.LeaveSubreddit{
-		Username:      req.Username,
+		Username:      currentUser(c),
 		SubredditName: subredditName,
 	})
 
@@ -145,75 +197,150 @@ func postToSubredditHandler(c *gin.Context) {
 	subredditName := c.Param("name")
 	var req struct {
 		Content string `json:"content"`
-		Author  string `json:"author"`
 	}
-	if err := c.ShouldBindJSON(&req); err != nil || req.Author == "" || req.Content == "" {
+	if err := c.ShouldBindJSON(&req); err != nil || req.Content == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post data"})
 		return
 	}
 
-	system.Root.Send(enginePID, &proto.PostToSubreddit{
+	future := system.Root.RequestFuture(enginePID, &proto.PostToSubreddit{
 		Content:       req.Content,
-		Author:        req.Author,
+		Author:        currentUser(c),
 		SubredditName: subredditName,
-	})
+	}, 5*time.Second)
 
-	c.JSON(http.StatusOK, gin.H{"message": "Post request sent"})
+	respondPost(c, future)
 }
 
 func commentOnPostHandler(c *gin.Context) {
 	postID := c.Param("post_id")
 	var req struct {
 		Content string `json:"content"`
-		Author  string `json:"author"`
 	}
-	if err := c.ShouldBindJSON(&req); err != nil || req.Author == "" || req.Content == "" {
+	if err := c.ShouldBindJSON(&req); err != nil || req.Content == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment data"})
 		return
 	}
 
-	system.Root.Send(enginePID, &proto.CommentOnPost{
+	future := system.Root.RequestFuture(enginePID, &proto.CommentOnPost{
 		Content: req.Content,
-		Author:  req.Author,
+		Author:  currentUser(c),
 		PostId:  postID,
-	})
+	}, 5*time.Second)
 
-	c.JSON(http.StatusOK, gin.H{"message": "Comment sent"})
+	respondComment(c, future)
 }
 
 func voteOnPostHandler(c *gin.Context) {
 	postID := c.Param("post_id")
 	var req struct {
-		Voter  string `json:"voter"`
-		Upvote bool   `json:"upvote"`
+		Upvote bool `json:"upvote"`
 	}
-	if err := c.ShouldBindJSON(&req); err != nil || req.Voter == "" {
+	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid vote data"})
 		return
 	}
 
-	system.Root.Send(enginePID, &proto.VoteOnPost{
+	future := system.Root.RequestFuture(enginePID, &proto.VoteOnPost{
 		PostId: postID,
 		Upvote: req.Upvote,
-		Voter:  req.Voter,
-	})
+		Voter:  currentUser(c),
+	}, 5*time.Second)
+
+	respondVote(c, future)
+}
+
+func retractPostVoteHandler(c *gin.Context) {
+	future := system.Root.RequestFuture(enginePID, &proto.VoteOnPost{
+		PostId:  c.Param("post_id"),
+		Voter:   currentUser(c),
+		Retract: true,
+	}, 5*time.Second)
 
-	c.JSON(http.StatusOK, gin.H{"message": "Vote submitted"})
+	respondVote(c, future)
+}
+
+func repostHandler(c *gin.Context) {
+	postID := c.Param("post_id")
+	var req struct {
+		SubredditName string `json:"subreddit_name"`
+		Content       string `json:"content"` // Optional, defaults to the original content
+	}
+	if err := c.ShouldBindJSON(&req); err != nil || req.SubredditName == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid repost data"})
+		return
+	}
+
+	future := system.Root.RequestFuture(enginePID, &proto.Repost{
+		Content:        req.Content,
+		Author:         currentUser(c),
+		OriginalPostId: postID,
+		SubredditName:  req.SubredditName,
+	}, 5*time.Second)
+
+	respondPost(c, future)
+}
+
+func replyToCommentHandler(c *gin.Context) {
+	commentID := c.Param("comment_id")
+	var req struct {
+		Content string `json:"content"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil || req.Content == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid reply data"})
+		return
+	}
+
+	future := system.Root.RequestFuture(enginePID, &proto.CommentOnComment{
+		Content:         req.Content,
+		Author:          currentUser(c),
+		ParentCommentId: commentID,
+	}, 5*time.Second)
+
+	respondComment(c, future)
+}
+
+func voteOnCommentHandler(c *gin.Context) {
+	commentID := c.Param("comment_id")
+	var req struct {
+		Upvote bool `json:"upvote"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid vote data"})
+		return
+	}
+
+	future := system.Root.RequestFuture(enginePID, &proto.VoteOnComment{
+		CommentId: commentID,
+		Upvote:    req.Upvote,
+		Voter:     currentUser(c),
+	}, 5*time.Second)
+
+	respondVote(c, future)
+}
+
+func retractCommentVoteHandler(c *gin.Context) {
+	future := system.Root.RequestFuture(enginePID, &proto.VoteOnComment{
+		CommentId: c.Param("comment_id"),
+		Voter:     currentUser(c),
+		Retract:   true,
+	}, 5*time.Second)
+
+	respondVote(c, future)
 }
 
 func sendDirectMessageHandler(c *gin.Context) {
 	var req struct {
-		FromUsername string `json:"from_username"`
-		ToUsername   string `json:"to_username"`
-		Content      string `json:"content"`
+		ToUsername string `json:"to_username"`
+		Content    string `json:"content"`
 	}
-	if err := c.ShouldBindJSON(&req); err != nil || req.FromUsername == "" || req.ToUsername == "" || req.Content == "" {
+	if err := c.ShouldBindJSON(&req); err != nil || req.ToUsername == "" || req.Content == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid message data"})
 		return
 	}
 
 	system.Root.Send(enginePID, &proto.SendDirectMessage{
-		FromUsername: req.FromUsername,
+		FromUsername: currentUser(c),
 		ToUsername:   req.ToUsername,
 		Content:      req.Content,
 	})
@@ -221,11 +348,130 @@ func sendDirectMessageHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Message sent"})
 }
 
+const (
+	defaultCommentDepth = 10
+	defaultCommentLimit = 100
+)
+
+func getPostHandler(c *gin.Context) {
+	depth, limit, ok := commentTreeParams(c)
+	if !ok {
+		return
+	}
+
+	future := system.Root.RequestFuture(enginePID, &proto.GetPostWithComments{
+		PostId:   c.Param("post_id"),
+		MaxDepth: depth,
+		Limit:    limit,
+		After:    c.Query("after"),
+	}, 5*time.Second)
+
+	result, err := future.Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Timeout or error"})
+		return
+	}
+
+	switch resp := result.(type) {
+	case *proto.PostWithComments:
+		c.JSON(http.StatusOK, gin.H{"post": resp.Post, "comments": resp.Comments, "more_comments": resp.MoreComments, "timed_out_comments": resp.TimedOutComments})
+	case *proto.NotFound:
+		respondNotFound(c, resp)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
+// getCommentHandler returns a comment subtree, used to load more replies below a comment
+func getCommentHandler(c *gin.Context) {
+	depth, limit, ok := commentTreeParams(c)
+	if !ok {
+		return
+	}
+
+	future := system.Root.RequestFuture(enginePID, &proto.GetComment{
+		CommentId: c.Param("comment_id"),
+		MaxDepth:  depth,
+		Limit:     limit,
+		After:     c.Query("after"),
+	}, 5*time.Second)
+
+	result, err := future.Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Timeout or error"})
+		return
+	}
+
+	switch resp := result.(type) {
+	case *proto.CommentNode:
+		c.JSON(http.StatusOK, gin.H{"comment": resp})
+	case *proto.NotFound:
+		respondNotFound(c, resp)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
+func commentTreeParams(c *gin.Context) (depth, limit int32, ok bool) {
+	depth, err := queryInt32(c, "depth", defaultCommentDepth)
+	if err != nil || depth < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid depth"})
+		return 0, 0, false
+	}
+	limit, err = queryInt32(c, "limit", defaultCommentLimit)
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit"})
+		return 0, 0, false
+	}
+	return depth, limit, true
+}
+
+func queryInt32(c *gin.Context, name string, defaultValue int32) (int32, error) {
+	value := c.Query(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	parsed, err := strconv.ParseInt(value, 10, 32)
+	return int32(parsed), err
+}
+
+func getUserProfileHandler(c *gin.Context) {
+	future := system.Root.RequestFuture(enginePID, &proto.GetUserProfile{
+		Username: c.Param("username"),
+	}, 5*time.Second)
+
+	result, err := future.Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Timeout or error"})
+		return
+	}
+
+	switch resp := result.(type) {
+	case *proto.UserProfile:
+		c.JSON(http.StatusOK, gin.H{"profile": resp})
+	case *proto.NotFound:
+		respondNotFound(c, resp)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
 func getInboxHandler(c *gin.Context) {
 	username := c.Param("username")
+	if username != currentUser(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Cannot read another user's inbox"})
+		return
+	}
+
+	cursor, pageSize, ok := pageParams(c)
+	if !ok {
+		return
+	}
 
 	future := system.Root.RequestFuture(enginePID, &proto.GetInbox{
 		Username: username,
+		Cursor:   cursor,
+		PageSize: pageSize,
 	}, 5*time.Second)
 
 	result, err := future.Result()
@@ -233,15 +479,33 @@ func getInboxHandler(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Timeout or error"})
 		return
 	}
-	inbox := result.(*proto.Inbox)
-	c.JSON(http.StatusOK, gin.H{"inbox": inbox.Messages})
+	switch resp := result.(type) {
+	case *proto.Inbox:
+		c.JSON(http.StatusOK, gin.H{"inbox": resp.Messages, "next_cursor": resp.NextCursor})
+	case *proto.NotFound:
+		respondNotFound(c, resp)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
 }
 
 func getFeedHandler(c *gin.Context) {
 	username := c.Param("username")
+	sort, timeWindow, ok := sortParams(c, actors.SortHot)
+	if !ok {
+		return
+	}
+	cursor, pageSize, ok := pageParams(c)
+	if !ok {
+		return
+	}
 
 	future := system.Root.RequestFuture(enginePID, &proto.GetFeed{
-		Username: username,
+		Username:   username,
+		Sort:       sort,
+		TimeWindow: timeWindow,
+		Cursor:     cursor,
+		PageSize:   pageSize,
 	}, 5*time.Second)
 
 	result, err := future.Result()
@@ -249,6 +513,134 @@ func getFeedHandler(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Timeout or error"})
 		return
 	}
-	feed := result.(*proto.Feed)
-	c.JSON(http.StatusOK, gin.H{"feed": feed.Posts})
+	switch resp := result.(type) {
+	case *proto.Feed:
+		c.JSON(http.StatusOK, gin.H{"feed": resp.Posts, "next_cursor": resp.NextCursor, "timed_out_subreddits": resp.TimedOutSubreddits})
+	case *proto.NotFound:
+		respondNotFound(c, resp)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
+func getSubredditPostsHandler(c *gin.Context) {
+	sort, timeWindow, ok := sortParams(c, actors.SortNew)
+	if !ok {
+		return
+	}
+	cursor, pageSize, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	future := system.Root.RequestFuture(enginePID, &proto.GetSubredditPosts{
+		SubredditName: c.Param("name"),
+		Sort:          sort,
+		TimeWindow:    timeWindow,
+		Cursor:        cursor,
+		PageSize:      pageSize,
+	}, 5*time.Second)
+
+	result, err := future.Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Timeout or error"})
+		return
+	}
+
+	switch resp := result.(type) {
+	case *proto.SubredditPosts:
+		c.JSON(http.StatusOK, gin.H{"posts": resp.Posts, "next_cursor": resp.NextCursor})
+	case *proto.NotFound:
+		respondNotFound(c, resp)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
+func sortParams(c *gin.Context, defaultSort string) (sort, timeWindow string, ok bool) {
+	sort = c.DefaultQuery("sort", defaultSort)
+	if !actors.IsValidFeedSort(sort) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sort, use hot, new, top, rising or controversial"})
+		return "", "", false
+	}
+	timeWindow = c.DefaultQuery("t", "all")
+	if !actors.IsValidTimeWindow(timeWindow) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid time window, use hour, day, week or all"})
+		return "", "", false
+	}
+	return sort, timeWindow, true
+}
+
+const (
+	defaultPageSize = 25
+	maxPageSize     = 100
+)
+
+func pageParams(c *gin.Context) (cursor string, pageSize int32, ok bool) {
+	cursor = c.Query("cursor")
+	if _, err := utils.DecodeCursor(cursor); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid cursor"})
+		return "", 0, false
+	}
+	pageSize, err := queryInt32(c, "limit", defaultPageSize)
+	if err != nil || pageSize < 1 || pageSize > maxPageSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid limit, use 1 to %d", maxPageSize)})
+		return "", 0, false
+	}
+	return cursor, pageSize, true
+}
+
+func respondPost(c *gin.Context, future *actor.Future) {
+	result, err := future.Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine timeout or error"})
+		return
+	}
+
+	switch resp := result.(type) {
+	case *proto.PostResponse:
+		c.JSON(http.StatusOK, gin.H{"message": resp.Message, "post_id": resp.PostId})
+	case *proto.NotFound:
+		respondNotFound(c, resp)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
+func respondComment(c *gin.Context, future *actor.Future) {
+	result, err := future.Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine timeout or error"})
+		return
+	}
+
+	switch resp := result.(type) {
+	case *proto.CommentResponse:
+		c.JSON(http.StatusOK, gin.H{"message": resp.Message, "comment_id": resp.CommentId})
+	case *proto.NotFound:
+		respondNotFound(c, resp)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
+func respondVote(c *gin.Context, future *actor.Future) {
+	result, err := future.Result()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Engine timeout or error"})
+		return
+	}
+
+	switch resp := result.(type) {
+	case *proto.VoteResponse:
+		c.JSON(http.StatusOK, gin.H{"message": resp.Message, "upvotes": resp.Upvotes, "downvotes": resp.Downvotes})
+	case *proto.NotFound:
+		respondNotFound(c, resp)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unexpected engine response"})
+	}
+}
+
+func respondNotFound(c *gin.Context, nf *proto.NotFound) {
+	c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("%s %s not found", nf.Kind, nf.Id)})
 }
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/tejasriramparvathaneni/reddit_clone/actors"
+	"github.com/tejasriramparvathaneni/reddit_clone/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Seeded runs start their clock here so timestamps do not depend on when they ran
+var simulationEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// The simulator drives an in-process engine with users whose activity follows subreddit
+// popularity, then reports throughput and latency percentiles per operation. With -seed the
+// run is replayable: its digest can be compared between runs or between engine builds
+func main() {
+	config := SimulationConfig{}
+	flag.IntVar(&config.Users, "users", 1000, "Number of simulated users")
+	flag.IntVar(&config.Subreddits, "subreddits", 50, "Number of subreddits")
+	flag.IntVar(&config.Memberships, "memberships", 5, "Subreddits each user joins")
+	flag.IntVar(&config.Clients, "clients", 32, "Concurrent simulated clients")
+	flag.DurationVar(&config.Duration, "duration", 10*time.Second, "How long to run the workload")
+	flag.Float64Var(&config.ZipfS, "zipf-s", 1.1, "Zipf exponent for subreddit popularity, must be > 1")
+	flag.Float64Var(&config.ZipfV, "zipf-v", 1, "Zipf offset for subreddit popularity, must be >= 1")
+	flag.DurationVar(&config.OnlineTime, "online-time", 4*time.Second, "Mean time a user stays online, 0 keeps everyone online")
+	flag.DurationVar(&config.OfflineTime, "offline-time", 2*time.Second, "Mean time a user stays offline before reconnecting")
+	flag.Int64Var(&config.Seed, "seed", 0, "Seed for a deterministic run with a single client, 0 runs concurrently for -duration")
+	flag.IntVar(&config.Ops, "ops", 10000, "Operations in a seeded run")
+	flag.DurationVar(&config.Tick, "tick", time.Millisecond, "Simulated time between operations in a seeded run")
+	shards := flag.Int("shards", 0, "Engine shards behind a router, 0 runs a single engine")
+	flag.Parse()
+
+	if config.Users < 2 || config.Subreddits < 1 || config.ZipfS <= 1 || config.ZipfV < 1 {
+		log.Fatalf("Need at least 2 users and 1 subreddit, with -zipf-s > 1 and -zipf-v >= 1")
+	}
+	config.Memberships = min(max(config.Memberships, 1), config.Subreddits)
+
+	opts := []actors.EngineOption{actors.WithPasswordCost(bcrypt.MinCost), actors.WithShards(*shards)}
+	var clock *utils.ManualClock
+	if config.Seed != 0 {
+		clock = utils.NewManualClock(simulationEpoch)
+		opts = append(opts, actors.WithClock(clock))
+	}
+
+	system := actor.NewActorSystem()
+	enginePID, err := system.Root.SpawnNamed(actors.NewEngineProps(opts...), "engine")
+	if err != nil {
+		log.Fatalf("Failed to spawn engine actor: %v\n", err)
+	}
+
+	simulation := NewSimulation(system, enginePID, config, clock)
+	start := time.Now()
+	if err := simulation.Setup(); err != nil {
+		log.Fatalf("Setup failed: %v\n", err)
+	}
+	fmt.Printf("Created %d users and %d subreddits with %d memberships in %v\n",
+		config.Users, config.Subreddits, simulation.MembershipCount(), time.Since(start).Round(time.Millisecond))
+
+	var report *Report
+	if config.Seed != 0 {
+		report = simulation.RunSeeded()
+	} else {
+		report = simulation.Run()
+	}
+	report.Print()
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+)
+
+// clientActor stands in for a user's device and counts the notifications pushed to it
+type clientActor struct {
+	stats *Stats
+}
+
+func (client *clientActor) Receive(context actor.Context) {
+	if notification, ok := context.Message().(*proto.Notification); ok {
+		client.stats.RecordNotification(notification.Queued)
+	}
+}
+
+// connectMessage brings username online with a client actor receiving its notifications
+func (sim *Simulation) connectMessage(username string) *proto.Connect {
+	clientPID := sim.clients[username]
+	return &proto.Connect{
+		Username:  username,
+		ClientPid: &proto.PID{Address: clientPID.Address, Id: clientPID.Id},
+	}
+}
+
+func (sim *Simulation) connect(username string) {
+	if _, err := sim.timed("connect", sim.connectMessage(username)); err == nil {
+		sim.online[username].Store(true)
+	}
+}
+
+func (sim *Simulation) disconnect(username string) {
+	// Stop acting as the user before the engine starts queueing for it
+	sim.online[username].Store(false)
+	sim.timed("disconnect", &proto.Disconnect{Username: username})
+}
+
+// cyclePresence takes every user offline and back online, spending exponentially
+// distributed times around the configured means in each state
+func (sim *Simulation) cyclePresence(deadline time.Time, wg *sync.WaitGroup) {
+	for i, username := range sim.users {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			random := rand.New(rand.NewSource(time.Now().UnixNano() + int64(i)))
+			for {
+				if !sleepUntil(deadline, exponential(random, sim.config.OnlineTime)) {
+					return
+				}
+				sim.disconnect(username)
+				if !sleepUntil(deadline, exponential(random, sim.config.OfflineTime)) {
+					return
+				}
+				sim.connect(username)
+			}
+		}()
+	}
+}
+
+func exponential(random *rand.Rand, mean time.Duration) time.Duration {
+	return time.Duration(random.ExpFloat64() * float64(mean))
+}
+
+// sleepUntil sleeps for d unless that passes deadline, and reports whether it slept
+func sleepUntil(deadline time.Time, d time.Duration) bool {
+	if time.Now().Add(d).After(deadline) {
+		return false
+	}
+	time.Sleep(d)
+	return true
+}
+
+// presenceSchedule holds when each user next goes offline or online in a seeded run,
+// on the simulation clock
+type presenceSchedule struct {
+	next []time.Time
+}
+
+func (sim *Simulation) schedulePresence(random *rand.Rand) *presenceSchedule {
+	schedule := &presenceSchedule{}
+	if sim.config.OnlineTime <= 0 || sim.config.OfflineTime <= 0 {
+		return schedule
+	}
+	now := sim.clock.Now()
+	for range sim.users {
+		schedule.next = append(schedule.next, now.Add(exponential(random, sim.config.OnlineTime)))
+	}
+	return schedule
+}
+
+// toggle takes every user whose time has come offline, or back online
+func (schedule *presenceSchedule) toggle(sim *Simulation, random *rand.Rand) {
+	now := sim.clock.Now()
+	for i, at := range schedule.next {
+		if now.Before(at) {
+			continue
+		}
+		username := sim.users[i]
+		if sim.online[username].Load() {
+			sim.disconnect(username)
+			schedule.next[i] = now.Add(exponential(random, sim.config.OfflineTime))
+		} else {
+			sim.connect(username)
+			schedule.next[i] = now.Add(exponential(random, sim.config.OnlineTime))
+		}
+	}
+}
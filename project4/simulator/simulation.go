@@ -0,0 +1,322 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/tejasriramparvathaneni/reddit_clone/proto"
+	"github.com/tejasriramparvathaneni/reddit_clone/utils"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const requestTimeout = 5 * time.Second
+
+// recentLimit caps how many post and comment IDs are remembered per subreddit as targets
+const recentLimit = 100
+
+type SimulationConfig struct {
+	Users       int
+	Subreddits  int
+	Memberships int
+	Clients     int
+	Duration    time.Duration
+	ZipfS       float64
+	ZipfV       float64
+	// Mean time users stay online and offline; zero keeps everyone online
+	OnlineTime  time.Duration
+	OfflineTime time.Duration
+	// A non-zero seed runs Ops operations one at a time on a manual clock moved by Tick
+	Seed int64
+	Ops  int
+	Tick time.Duration
+}
+
+// Subreddits are ranked by popularity: subreddit 0 is joined and used the most
+type simulatedSubreddit struct {
+	name     string
+	members  []string
+	mu       sync.Mutex
+	posts    []string
+	comments []string
+}
+
+type Simulation struct {
+	system     *actor.ActorSystem
+	enginePID  *actor.PID
+	config     SimulationConfig
+	users      []string
+	subreddits []*simulatedSubreddit
+	clients    map[string]*actor.PID
+	online     map[string]*atomic.Bool
+	stats      *Stats
+	// Only set for seeded runs
+	clock  *utils.ManualClock
+	digest hash.Hash
+}
+
+// NewSimulation drives the engine at enginePID; clock must be the engine's clock for seeded runs
+func NewSimulation(system *actor.ActorSystem, enginePID *actor.PID, config SimulationConfig, clock *utils.ManualClock) *Simulation {
+	sim := &Simulation{
+		system:    system,
+		enginePID: enginePID,
+		config:    config,
+		clients:   make(map[string]*actor.PID),
+		online:    make(map[string]*atomic.Bool),
+		stats:     NewStats(),
+		clock:     clock,
+	}
+	if config.Seed != 0 {
+		sim.digest = sha256.New()
+	}
+	return sim
+}
+
+// Setup registers the users, creates the subreddits and has every user join subreddits
+// drawn from the Zipf distribution
+func (sim *Simulation) Setup() error {
+	for i := 0; i < sim.config.Users; i++ {
+		username := fmt.Sprintf("user_%d", i)
+		res, err := sim.request(&proto.RegisterUser{Username: username, Password: "password"})
+		if response, ok := res.(*proto.RegistrationResponse); err != nil || !ok || !response.Success {
+			return fmt.Errorf("registering %s: %v %v", username, res, err)
+		}
+		sim.users = append(sim.users, username)
+		sim.clients[username] = sim.system.Root.Spawn(actor.PropsFromProducer(func() actor.Actor {
+			return &clientActor{stats: sim.stats}
+		}))
+		sim.online[username] = &atomic.Bool{}
+		if _, err := sim.request(sim.connectMessage(username)); err != nil {
+			return fmt.Errorf("connecting %s: %v", username, err)
+		}
+		sim.online[username].Store(true)
+	}
+
+	for i := 0; i < sim.config.Subreddits; i++ {
+		subreddit := &simulatedSubreddit{name: fmt.Sprintf("sub_%d", i)}
+		sim.system.Root.Send(sim.enginePID, &proto.CreateSubreddit{Name: subreddit.name})
+		sim.subreddits = append(sim.subreddits, subreddit)
+	}
+
+	zipf := sim.newZipf(0)
+	for _, username := range sim.users {
+		joined := make(map[uint64]bool)
+		for len(joined) < sim.config.Memberships {
+			rank := zipf.Uint64()
+			if joined[rank] {
+				continue
+			}
+			joined[rank] = true
+			subreddit := sim.subreddits[rank]
+			subreddit.members = append(subreddit.members, username)
+			sim.system.Root.Send(sim.enginePID, &proto.JoinSubreddit{Username: username, SubredditName: subreddit.name})
+		}
+	}
+
+	// Joins are not acknowledged; a request queued behind them on every subreddit
+	// means they have all been applied
+	for _, subreddit := range sim.subreddits {
+		if _, err := sim.request(&proto.GetSubredditPosts{SubredditName: subreddit.name, PageSize: 1}); err != nil {
+			return fmt.Errorf("waiting for %s: %v", subreddit.name, err)
+		}
+	}
+	return nil
+}
+
+func (sim *Simulation) MembershipCount() int {
+	count := 0
+	for _, subreddit := range sim.subreddits {
+		count += len(subreddit.members)
+	}
+	return count
+}
+
+// Run has every client pick a subreddit by popularity and act as one of its online members
+// until the duration is up
+func (sim *Simulation) Run() *Report {
+	deadline := time.Now().Add(sim.config.Duration)
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	if sim.config.OnlineTime > 0 && sim.config.OfflineTime > 0 {
+		sim.cyclePresence(deadline, &wg)
+	}
+	for i := 0; i < sim.config.Clients; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			random := rand.New(rand.NewSource(time.Now().UnixNano() + int64(i)))
+			zipf := utils.NewZipfGenerator(sim.config.ZipfS, sim.config.ZipfV, uint64(sim.config.Subreddits-1))
+			for time.Now().Before(deadline) {
+				subreddit := sim.subreddits[zipf.Uint64()]
+				if len(subreddit.members) == 0 {
+					continue
+				}
+				username := subreddit.members[random.Intn(len(subreddit.members))]
+				if !sim.online[username].Load() {
+					continue
+				}
+				sim.act(random, zipf, subreddit, username)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return sim.stats.Report(time.Since(start))
+}
+
+// RunSeeded replays the workload of the configured seed. A single client acts and the clock
+// only moves one tick per operation, so any build of the engine that behaves the same
+// reports the same digest
+func (sim *Simulation) RunSeeded() *Report {
+	start := time.Now()
+	random := rand.New(rand.NewSource(sim.config.Seed))
+	zipf := sim.newZipf(1)
+	presence := sim.schedulePresence(random)
+	for i := 0; i < sim.config.Ops; i++ {
+		sim.clock.Advance(sim.config.Tick)
+		presence.toggle(sim, random)
+		subreddit := sim.subreddits[zipf.Uint64()]
+		if len(subreddit.members) == 0 {
+			continue
+		}
+		username := subreddit.members[random.Intn(len(subreddit.members))]
+		if !sim.online[username].Load() {
+			continue
+		}
+		sim.act(random, zipf, subreddit, username)
+	}
+	sim.drain()
+
+	report := sim.stats.Report(time.Since(start))
+	fmt.Fprintf(sim.digest, "notifications %d %d\n", report.LiveNotifications, report.QueuedNotifications)
+	report.Digest = hex.EncodeToString(sim.digest.Sum(nil))
+	return report
+}
+
+// drain waits for the messages still in flight after the last operation and folds every
+// user's final profile into the digest
+func (sim *Simulation) drain() {
+	for _, username := range sim.users {
+		res, err := sim.request(&proto.GetUserProfile{Username: username})
+		if err != nil {
+			fmt.Printf("Failed to fetch profile of %s: %v\n", username, err)
+		}
+		sim.record("profile", res)
+	}
+	// Touch is answered by the actor system once the client has handled its notifications
+	for _, username := range sim.users {
+		sim.system.Root.RequestFuture(sim.clients[username], &actor.Touch{}, requestTimeout).Wait()
+	}
+}
+
+// newZipf seeds the generator from the simulation seed plus stream, or from the time for
+// unseeded runs
+func (sim *Simulation) newZipf(stream int64) *rand.Zipf {
+	imax := uint64(sim.config.Subreddits - 1)
+	if sim.config.Seed == 0 {
+		return utils.NewZipfGenerator(sim.config.ZipfS, sim.config.ZipfV, imax)
+	}
+	return utils.NewSeededZipfGenerator(sim.config.Seed+stream, sim.config.ZipfS, sim.config.ZipfV, imax)
+}
+
+// act performs one operation, falling back to posting while a subreddit has no targets yet
+func (sim *Simulation) act(random *rand.Rand, zipf *rand.Zipf, subreddit *simulatedSubreddit, username string) {
+	postID, commentID := subreddit.targets(random)
+	roll := random.Intn(100)
+	switch {
+	case postID == "" || roll < 20:
+		sim.post(subreddit, username)
+	case roll < 45:
+		sim.comment(subreddit, username, postID)
+	case roll < 65:
+		sim.timed("vote_post", &proto.VoteOnPost{PostId: postID, Voter: username, Upvote: random.Intn(4) > 0})
+	case roll < 80 && commentID != "":
+		sim.timed("vote_comment", &proto.VoteOnComment{CommentId: commentID, Voter: username, Upvote: random.Intn(4) > 0})
+	case roll < 88:
+		// Reposts also land where the traffic is
+		target := sim.subreddits[zipf.Uint64()]
+		sim.timed("repost", &proto.Repost{OriginalPostId: postID, Author: username, SubredditName: target.name})
+	default:
+		recipient := sim.users[random.Intn(len(sim.users))]
+		message := &proto.SendDirectMessage{FromUsername: username, ToUsername: recipient, Content: "Hello"}
+		start := time.Now()
+		sim.system.Root.Send(sim.enginePID, message)
+		sim.stats.Record("direct_message", time.Since(start), nil)
+		sim.record("direct_message", message)
+	}
+}
+
+func (sim *Simulation) post(subreddit *simulatedSubreddit, username string) {
+	res, err := sim.timed("post", &proto.PostToSubreddit{Content: "Simulated post", Author: username, SubredditName: subreddit.name})
+	if response, ok := res.(*proto.PostResponse); err == nil && ok && response.Success {
+		subreddit.remember(&subreddit.posts, response.PostId)
+	}
+}
+
+func (sim *Simulation) comment(subreddit *simulatedSubreddit, username, postID string) {
+	res, err := sim.timed("comment", &proto.CommentOnPost{Content: "Simulated comment", Author: username, PostId: postID})
+	if response, ok := res.(*proto.CommentResponse); err == nil && ok && response.Success {
+		subreddit.remember(&subreddit.comments, response.CommentId)
+	}
+}
+
+// timed sends a request to the engine and records its latency under op
+func (sim *Simulation) timed(op string, msg interface{}) (interface{}, error) {
+	start := time.Now()
+	res, err := sim.request(msg)
+	if err == nil {
+		if notFound, ok := res.(*proto.NotFound); ok {
+			err = fmt.Errorf("%s %s not found", notFound.Kind, notFound.Id)
+		}
+	}
+	sim.stats.Record(op, time.Since(start), err)
+	sim.record(op, res)
+	return res, err
+}
+
+// record folds an operation and the engine's response into the digest of a seeded run
+func (sim *Simulation) record(op string, res interface{}) {
+	if sim.digest == nil {
+		return
+	}
+	fmt.Fprintf(sim.digest, "%s\n", op)
+	if msg, ok := res.(protobuf.Message); ok {
+		data, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			fmt.Printf("Failed to digest %s response: %v\n", op, err)
+		}
+		sim.digest.Write(data)
+	}
+}
+
+func (sim *Simulation) request(msg interface{}) (interface{}, error) {
+	return sim.system.Root.RequestFuture(sim.enginePID, msg, requestTimeout).Result()
+}
+
+func (subreddit *simulatedSubreddit) targets(random *rand.Rand) (postID, commentID string) {
+	subreddit.mu.Lock()
+	defer subreddit.mu.Unlock()
+	if len(subreddit.posts) > 0 {
+		postID = subreddit.posts[random.Intn(len(subreddit.posts))]
+	}
+	if len(subreddit.comments) > 0 {
+		commentID = subreddit.comments[random.Intn(len(subreddit.comments))]
+	}
+	return postID, commentID
+}
+
+func (subreddit *simulatedSubreddit) remember(ids *[]string, id string) {
+	subreddit.mu.Lock()
+	defer subreddit.mu.Unlock()
+	*ids = append(*ids, id)
+	if len(*ids) > recentLimit {
+		*ids = (*ids)[len(*ids)-recentLimit:]
+	}
+}
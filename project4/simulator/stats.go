@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Stats collects the latency of every operation, grouped by operation type
+type Stats struct {
+	mu        sync.Mutex
+	latencies map[string][]time.Duration
+	errors    map[string]int
+	// Notifications pushed to connected clients, and those held until a reconnect
+	liveNotifications   atomic.Int64
+	queuedNotifications atomic.Int64
+}
+
+func NewStats() *Stats {
+	return &Stats{
+		latencies: make(map[string][]time.Duration),
+		errors:    make(map[string]int),
+	}
+}
+
+func (stats *Stats) Record(op string, latency time.Duration, err error) {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	if err != nil {
+		stats.errors[op]++
+		return
+	}
+	stats.latencies[op] = append(stats.latencies[op], latency)
+}
+
+func (stats *Stats) RecordNotification(queued bool) {
+	if queued {
+		stats.queuedNotifications.Add(1)
+	} else {
+		stats.liveNotifications.Add(1)
+	}
+}
+
+type OpReport struct {
+	Op     string
+	Count  int
+	Errors int
+	P50    time.Duration
+	P90    time.Duration
+	P99    time.Duration
+	Max    time.Duration
+}
+
+type Report struct {
+	Elapsed             time.Duration
+	Ops                 []OpReport
+	LiveNotifications   int64
+	QueuedNotifications int64
+	// Digest of every response in a seeded run, equal across runs that behaved the same
+	Digest string
+}
+
+func (stats *Stats) Report(elapsed time.Duration) *Report {
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+
+	ops := make(map[string]bool)
+	for op := range stats.latencies {
+		ops[op] = true
+	}
+	for op := range stats.errors {
+		ops[op] = true
+	}
+
+	report := &Report{
+		Elapsed:             elapsed,
+		LiveNotifications:   stats.liveNotifications.Load(),
+		QueuedNotifications: stats.queuedNotifications.Load(),
+	}
+	for op := range ops {
+		latencies := append([]time.Duration{}, stats.latencies[op]...)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		opReport := OpReport{Op: op, Count: len(latencies), Errors: stats.errors[op]}
+		if len(latencies) > 0 {
+			opReport.P50 = percentile(latencies, 0.50)
+			opReport.P90 = percentile(latencies, 0.90)
+			opReport.P99 = percentile(latencies, 0.99)
+			opReport.Max = latencies[len(latencies)-1]
+		}
+		report.Ops = append(report.Ops, opReport)
+	}
+	sort.Slice(report.Ops, func(i, j int) bool { return report.Ops[i].Op < report.Ops[j].Op })
+	return report
+}
+
+// percentile uses the nearest-rank method on sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(p*float64(len(sorted))+0.5) - 1
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
+
+func (report *Report) Print() {
+	total := 0
+	fmt.Printf("\n%-16s %9s %7s %10s %10s %10s %10s %10s\n", "operation", "count", "errors", "ops/s", "p50", "p90", "p99", "max")
+	for _, op := range report.Ops {
+		total += op.Count
+		fmt.Printf("%-16s %9d %7d %10.1f %10v %10v %10v %10v\n", op.Op, op.Count, op.Errors,
+			float64(op.Count)/report.Elapsed.Seconds(), round(op.P50), round(op.P90), round(op.P99), round(op.Max))
+	}
+	fmt.Printf("\n%d operations in %v: %.1f ops/s\n", total, report.Elapsed.Round(time.Millisecond), float64(total)/report.Elapsed.Seconds())
+	fmt.Println("direct_message latency is the time to enqueue it, the engine does not acknowledge DMs")
+	fmt.Printf("Notifications: %d delivered live, %d queued while offline and delivered on reconnect\n",
+		report.LiveNotifications, report.QueuedNotifications)
+	if report.Digest != "" {
+		fmt.Printf("Digest: %s\n", report.Digest)
+	}
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Microsecond)
+}
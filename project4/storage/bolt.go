@@ -0,0 +1,260 @@
+package storage
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sort"
+
+	"github.com/tejasriramparvathaneni/reddit_clone/models"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	usersBucket      = []byte("users")
+	subredditsBucket = []byte("subreddits")
+	postsBucket      = []byte("posts")
+	commentsBucket   = []byte("comments")
+	messagesBucket   = []byte("messages")
+	// Indexes hold one nested bucket per parent, keyed by the child's ID
+	subredditPostsBucket = []byte("subreddit_posts")
+	postCommentsBucket   = []byte("post_comments")
+)
+
+// BoltRepository stores each entity as JSON in an embedded BoltDB file
+type BoltRepository struct {
+	db *bolt.DB
+}
+
+func NewBoltRepository(path string) (*BoltRepository, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{usersBucket, subredditsBucket, postsBucket, commentsBucket, messagesBucket, subredditPostsBucket, postCommentsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &BoltRepository{db: db}, nil
+}
+
+func (repo *BoltRepository) SaveUser(user *models.User) error {
+	return repo.put(usersBucket, user.Username, user)
+}
+
+func (repo *BoltRepository) GetUser(username string) (*models.User, error) {
+	user := &models.User{}
+	if err := repo.get(usersBucket, username, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (repo *BoltRepository) ListUsers() ([]*models.User, error) {
+	users := []*models.User{}
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(usersBucket).ForEach(func(_, value []byte) error {
+			user := &models.User{}
+			users = append(users, user)
+			return json.Unmarshal(value, user)
+		})
+	})
+	return users, err
+}
+
+func (repo *BoltRepository) SaveSubreddit(subreddit *models.Subreddit) error {
+	return repo.put(subredditsBucket, subreddit.Name, subreddit)
+}
+
+func (repo *BoltRepository) GetSubreddit(name string) (*models.Subreddit, error) {
+	subreddit := &models.Subreddit{}
+	if err := repo.get(subredditsBucket, name, subreddit); err != nil {
+		return nil, err
+	}
+	return subreddit, nil
+}
+
+func (repo *BoltRepository) ListSubreddits() ([]*models.Subreddit, error) {
+	subreddits := []*models.Subreddit{}
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(subredditsBucket).ForEach(func(_, value []byte) error {
+			subreddit := &models.Subreddit{}
+			subreddits = append(subreddits, subreddit)
+			return json.Unmarshal(value, subreddit)
+		})
+	})
+	return subreddits, err
+}
+
+func (repo *BoltRepository) SavePost(post *models.Post) error {
+	if err := repo.put(postsBucket, post.PostID, post); err != nil {
+		return err
+	}
+	return repo.index(subredditPostsBucket, post.SubredditName, post.PostID)
+}
+
+func (repo *BoltRepository) GetPost(postID string) (*models.Post, error) {
+	post := &models.Post{}
+	if err := repo.get(postsBucket, postID, post); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+func (repo *BoltRepository) ListPosts(subredditName string) ([]*models.Post, error) {
+	posts := []*models.Post{}
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		return forEachIndexed(tx, subredditPostsBucket, postsBucket, subredditName, func(value []byte) error {
+			post := &models.Post{}
+			posts = append(posts, post)
+			return json.Unmarshal(value, post)
+		})
+	})
+	return posts, err
+}
+
+func (repo *BoltRepository) SaveComment(comment *models.Comment) error {
+	if err := repo.put(commentsBucket, comment.CommentID, comment); err != nil {
+		return err
+	}
+	return repo.index(postCommentsBucket, comment.PostID, comment.CommentID)
+}
+
+func (repo *BoltRepository) GetComment(commentID string) (*models.Comment, error) {
+	comment := &models.Comment{}
+	if err := repo.get(commentsBucket, commentID, comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+func (repo *BoltRepository) ListComments(postID string) ([]*models.Comment, error) {
+	comments := []*models.Comment{}
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		return forEachIndexed(tx, postCommentsBucket, commentsBucket, postID, func(value []byte) error {
+			comment := &models.Comment{}
+			comments = append(comments, comment)
+			return json.Unmarshal(value, comment)
+		})
+	})
+	return comments, err
+}
+
+func (repo *BoltRepository) SaveMessage(message *models.Message) error {
+	value, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		inbox, err := tx.Bucket(messagesBucket).CreateBucketIfNotExists([]byte(message.ToUsername))
+		if err != nil {
+			return err
+		}
+		sequence, err := inbox.NextSequence()
+		if err != nil {
+			return err
+		}
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, sequence)
+		return inbox.Put(key, value)
+	})
+}
+
+func (repo *BoltRepository) ListMessages(toUsername string) ([]*models.Message, error) {
+	messages := []*models.Message{}
+	err := repo.db.View(func(tx *bolt.Tx) error {
+		inbox := tx.Bucket(messagesBucket).Bucket([]byte(toUsername))
+		if inbox == nil {
+			return nil
+		}
+		return inbox.ForEach(func(_, value []byte) error {
+			message := &models.Message{}
+			messages = append(messages, message)
+			return json.Unmarshal(value, message)
+		})
+	})
+	return messages, err
+}
+
+func (repo *BoltRepository) Close() error {
+	return repo.db.Close()
+}
+
+func (repo *BoltRepository) put(bucket []byte, key string, entity interface{}) error {
+	value, err := json.Marshal(entity)
+	if err != nil {
+		return err
+	}
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put([]byte(key), value)
+	})
+}
+
+func (repo *BoltRepository) get(bucket []byte, key string, entity interface{}) error {
+	return repo.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(bucket).Get([]byte(key))
+		if value == nil {
+			return ErrNotFound
+		}
+		return json.Unmarshal(value, entity)
+	})
+}
+
+// index files child under parent with the next number of parent's children, the first time
+// it is saved, so children are listed in the order they were first saved
+func (repo *BoltRepository) index(bucket []byte, parent, child string) error {
+	return repo.db.Update(func(tx *bolt.Tx) error {
+		children, err := tx.Bucket(bucket).CreateBucketIfNotExists([]byte(parent))
+		if err != nil {
+			return err
+		}
+		if children.Get([]byte(child)) != nil {
+			return nil
+		}
+		sequence, err := children.NextSequence()
+		if err != nil {
+			return err
+		}
+		order := make([]byte, 8)
+		binary.BigEndian.PutUint64(order, sequence)
+		return children.Put([]byte(child), order)
+	})
+}
+
+func forEachIndexed(tx *bolt.Tx, index, bucket []byte, parent string, fn func(value []byte) error) error {
+	children := tx.Bucket(index).Bucket([]byte(parent))
+	if children == nil {
+		return nil
+	}
+	type indexed struct {
+		child []byte
+		order uint64
+	}
+	var ordered []indexed
+	children.ForEach(func(child, order []byte) error {
+		entry := indexed{child: child}
+		if len(order) == 8 {
+			entry.order = binary.BigEndian.Uint64(order)
+		}
+		ordered = append(ordered, entry)
+		return nil
+	})
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].order < ordered[j].order })
+
+	entities := tx.Bucket(bucket)
+	for _, entry := range ordered {
+		if value := entities.Get(entry.child); value != nil {
+			if err := fn(value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
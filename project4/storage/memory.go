@@ -0,0 +1,189 @@
+package storage
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/tejasriramparvathaneni/reddit_clone/models"
+)
+
+// MemoryRepository keeps everything in maps and loses it when the process exits
+type MemoryRepository struct {
+	mu         sync.RWMutex
+	users      map[string]models.User
+	subreddits map[string]models.Subreddit
+	posts      map[string]models.Post
+	comments   map[string]models.Comment
+	messages   map[string][]models.Message
+	// Number of every post and comment in the order they were first saved, for listing
+	saved map[string]int
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		users:      make(map[string]models.User),
+		subreddits: make(map[string]models.Subreddit),
+		posts:      make(map[string]models.Post),
+		comments:   make(map[string]models.Comment),
+		messages:   make(map[string][]models.Message),
+		saved:      make(map[string]int),
+	}
+}
+
+func (repo *MemoryRepository) SaveUser(user *models.User) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	stored := *user
+	stored.PID = nil
+	repo.users[user.Username] = stored
+	return nil
+}
+
+func (repo *MemoryRepository) GetUser(username string) (*models.User, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	user, exists := repo.users[username]
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return &user, nil
+}
+
+func (repo *MemoryRepository) ListUsers() ([]*models.User, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	users := make([]*models.User, 0, len(repo.users))
+	for _, user := range repo.users {
+		users = append(users, &user)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Username < users[j].Username })
+	return users, nil
+}
+
+func (repo *MemoryRepository) SaveSubreddit(subreddit *models.Subreddit) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	stored := *subreddit
+	stored.PID = nil
+	repo.subreddits[subreddit.Name] = stored
+	return nil
+}
+
+func (repo *MemoryRepository) GetSubreddit(name string) (*models.Subreddit, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	subreddit, exists := repo.subreddits[name]
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return &subreddit, nil
+}
+
+func (repo *MemoryRepository) ListSubreddits() ([]*models.Subreddit, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	subreddits := make([]*models.Subreddit, 0, len(repo.subreddits))
+	for _, subreddit := range repo.subreddits {
+		subreddits = append(subreddits, &subreddit)
+	}
+	sort.Slice(subreddits, func(i, j int) bool { return subreddits[i].Name < subreddits[j].Name })
+	return subreddits, nil
+}
+
+func (repo *MemoryRepository) SavePost(post *models.Post) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	stored := *post
+	stored.PID = nil
+	repo.posts[post.PostID] = stored
+	repo.numberSave("post/" + post.PostID)
+	return nil
+}
+
+func (repo *MemoryRepository) GetPost(postID string) (*models.Post, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	post, exists := repo.posts[postID]
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return &post, nil
+}
+
+func (repo *MemoryRepository) ListPosts(subredditName string) ([]*models.Post, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	posts := []*models.Post{}
+	for _, post := range repo.posts {
+		if post.SubredditName == subredditName {
+			posts = append(posts, &post)
+		}
+	}
+	sort.Slice(posts, func(i, j int) bool {
+		return repo.saved["post/"+posts[i].PostID] < repo.saved["post/"+posts[j].PostID]
+	})
+	return posts, nil
+}
+
+func (repo *MemoryRepository) SaveComment(comment *models.Comment) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	stored := *comment
+	stored.PID, stored.PostPID = nil, nil
+	repo.comments[comment.CommentID] = stored
+	repo.numberSave("comment/" + comment.CommentID)
+	return nil
+}
+
+func (repo *MemoryRepository) GetComment(commentID string) (*models.Comment, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	comment, exists := repo.comments[commentID]
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return &comment, nil
+}
+
+func (repo *MemoryRepository) ListComments(postID string) ([]*models.Comment, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	comments := []*models.Comment{}
+	for _, comment := range repo.comments {
+		if comment.PostID == postID {
+			comments = append(comments, &comment)
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		return repo.saved["comment/"+comments[i].CommentID] < repo.saved["comment/"+comments[j].CommentID]
+	})
+	return comments, nil
+}
+
+func (repo *MemoryRepository) SaveMessage(message *models.Message) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	repo.messages[message.ToUsername] = append(repo.messages[message.ToUsername], *message)
+	return nil
+}
+
+func (repo *MemoryRepository) ListMessages(toUsername string) ([]*models.Message, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+	messages := make([]*models.Message, 0, len(repo.messages[toUsername]))
+	for _, message := range repo.messages[toUsername] {
+		messages = append(messages, &message)
+	}
+	return messages, nil
+}
+
+func (repo *MemoryRepository) Close() error {
+	return nil
+}
+
+// numberSave numbers key the first time it is saved
+func (repo *MemoryRepository) numberSave(key string) {
+	if _, exists := repo.saved[key]; !exists {
+		repo.saved[key] = len(repo.saved)
+	}
+}
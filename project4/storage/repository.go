@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tejasriramparvathaneni/reddit_clone/models"
+)
+
+var ErrNotFound = errors.New("not found")
+
+// Repository stores the engine's users, subreddits, posts, comments and direct messages.
+// Saving an existing entity replaces it. PIDs are never stored. Users and subreddits are
+// listed by name, posts and comments in the order they were first saved.
+type Repository interface {
+	SaveUser(user *models.User) error
+	GetUser(username string) (*models.User, error)
+	ListUsers() ([]*models.User, error)
+
+	SaveSubreddit(subreddit *models.Subreddit) error
+	GetSubreddit(name string) (*models.Subreddit, error)
+	ListSubreddits() ([]*models.Subreddit, error)
+
+	SavePost(post *models.Post) error
+	GetPost(postID string) (*models.Post, error)
+	ListPosts(subredditName string) ([]*models.Post, error)
+
+	SaveComment(comment *models.Comment) error
+	GetComment(commentID string) (*models.Comment, error)
+	ListComments(postID string) ([]*models.Comment, error)
+
+	// Messages are kept in the order they were saved
+	SaveMessage(message *models.Message) error
+	ListMessages(toUsername string) ([]*models.Message, error)
+
+	Close() error
+}
+
+const (
+	BackendMemory = "memory"
+	BackendBolt   = "bolt"
+	BackendSQLite = "sqlite"
+)
+
+// Open returns the repository for backend, storing it at path for the embedded databases
+func Open(backend, path string) (Repository, error) {
+	switch backend {
+	case BackendMemory:
+		return NewMemoryRepository(), nil
+	case BackendBolt:
+		return NewBoltRepository(path)
+	case BackendSQLite:
+		return NewSQLiteRepository(path)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", backend)
+	}
+}
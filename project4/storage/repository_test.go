@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/tejasriramparvathaneni/reddit_clone/models"
+)
+
+// TestRepositoryContract runs the same checks against every backend
+func TestRepositoryContract(t *testing.T) {
+	for _, backend := range []string{BackendMemory, BackendBolt, BackendSQLite} {
+		t.Run(backend, func(t *testing.T) {
+			repo, err := Open(backend, filepath.Join(t.TempDir(), "reddit.db"))
+			if err != nil {
+				t.Fatalf("Open: %v", err)
+			}
+			defer repo.Close()
+			testRepository(t, repo)
+		})
+	}
+}
+
+func testRepository(t *testing.T, repo Repository) {
+	pid := actor.NewPID("127.0.0.1:8080", "engine/user-bob")
+	must(t, repo.SaveUser(&models.User{Username: "bob", PasswordHash: "old", PID: pid}))
+	must(t, repo.SaveUser(&models.User{Username: "alice", PasswordHash: "hash"}))
+	must(t, repo.SaveUser(&models.User{Username: "bob", PasswordHash: "new"}))
+
+	user, err := repo.GetUser("bob")
+	must(t, err)
+	if user.PasswordHash != "new" || user.PID != nil {
+		t.Errorf("GetUser(bob) = %+v, want the saved hash and no PID", user)
+	}
+	if _, err := repo.GetUser("carol"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetUser(carol) error = %v, want ErrNotFound", err)
+	}
+	users, err := repo.ListUsers()
+	must(t, err)
+	if names := usernames(users); !reflect.DeepEqual(names, []string{"alice", "bob"}) {
+		t.Errorf("ListUsers = %v, want alice, bob", names)
+	}
+
+	must(t, repo.SaveSubreddit(&models.Subreddit{Name: "golang"}))
+	must(t, repo.SaveSubreddit(&models.Subreddit{Name: "cats"}))
+	subreddits, err := repo.ListSubreddits()
+	must(t, err)
+	if len(subreddits) != 2 || subreddits[0].Name != "cats" || subreddits[1].Name != "golang" {
+		t.Errorf("ListSubreddits = %+v, want cats, golang", subreddits)
+	}
+	if _, err := repo.GetSubreddit("dogs"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetSubreddit(dogs) error = %v, want ErrNotFound", err)
+	}
+
+	// Twelve posts, so golang_10 would sort before golang_2 by ID
+	var wantPosts []string
+	for i := 1; i <= 12; i++ {
+		postID := fmt.Sprintf("golang_%d", i)
+		wantPosts = append(wantPosts, postID)
+		must(t, repo.SavePost(&models.Post{PostID: postID, SubredditName: "golang", Content: "first", Author: "bob"}))
+	}
+	must(t, repo.SavePost(&models.Post{PostID: "cats_1", SubredditName: "cats", Content: "meow", Author: "alice"}))
+	must(t, repo.SavePost(&models.Post{PostID: "golang_2", SubredditName: "golang", Content: "edited", Author: "bob"}))
+
+	post, err := repo.GetPost("golang_2")
+	must(t, err)
+	if post.Content != "edited" {
+		t.Errorf("GetPost(golang_2).Content = %q, want the latest save", post.Content)
+	}
+	if _, err := repo.GetPost("golang_13"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetPost(golang_13) error = %v, want ErrNotFound", err)
+	}
+	posts, err := repo.ListPosts("golang")
+	must(t, err)
+	var gotPosts []string
+	for _, post := range posts {
+		gotPosts = append(gotPosts, post.PostID)
+	}
+	if !reflect.DeepEqual(gotPosts, wantPosts) {
+		t.Errorf("ListPosts(golang) = %v, want %v", gotPosts, wantPosts)
+	}
+
+	for _, commentID := range []string{"golang_1_2", "golang_1_10", "golang_1_2_1"} {
+		must(t, repo.SaveComment(&models.Comment{CommentID: commentID, PostID: "golang_1", Content: "hi", Author: "alice"}))
+	}
+	must(t, repo.SaveComment(&models.Comment{CommentID: "golang_1_2", PostID: "golang_1", Content: "edited", Author: "alice"}))
+	comment, err := repo.GetComment("golang_1_2")
+	must(t, err)
+	if comment.Content != "edited" {
+		t.Errorf("GetComment(golang_1_2).Content = %q, want the latest save", comment.Content)
+	}
+	comments, err := repo.ListComments("golang_1")
+	must(t, err)
+	var gotComments []string
+	for _, comment := range comments {
+		gotComments = append(gotComments, comment.CommentID)
+	}
+	if want := []string{"golang_1_2", "golang_1_10", "golang_1_2_1"}; !reflect.DeepEqual(gotComments, want) {
+		t.Errorf("ListComments(golang_1) = %v, want %v", gotComments, want)
+	}
+	if comments, err := repo.ListComments("cats_1"); err != nil || len(comments) != 0 {
+		t.Errorf("ListComments(cats_1) = %v, %v, want none", comments, err)
+	}
+
+	for i, content := range []string{"hello", "again", "hello"} {
+		must(t, repo.SaveMessage(&models.Message{FromUsername: "alice", ToUsername: "bob", Content: content, Timestamp: int64(i)}))
+	}
+	messages, err := repo.ListMessages("bob")
+	must(t, err)
+	if len(messages) != 3 || messages[0].Content != "hello" || messages[1].Content != "again" || messages[2].Timestamp != 2 {
+		t.Errorf("ListMessages(bob) = %+v, want the three messages in order", messages)
+	}
+	if messages, err := repo.ListMessages("alice"); err != nil || len(messages) != 0 {
+		t.Errorf("ListMessages(alice) = %v, %v, want none", messages, err)
+	}
+}
+
+func usernames(users []*models.User) []string {
+	names := make([]string, 0, len(users))
+	for _, user := range users {
+		names = append(names, user.Username)
+	}
+	return names
+}
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
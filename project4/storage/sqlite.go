@@ -0,0 +1,236 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/tejasriramparvathaneni/reddit_clone/models"
+	_ "modernc.org/sqlite"
+)
+
+// sqliteDriver is the name the pure-Go driver registers with database/sql
+const sqliteDriver = "sqlite"
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS users (
+	username TEXT PRIMARY KEY,
+	password_hash TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS subreddits (
+	name TEXT PRIMARY KEY
+);
+CREATE TABLE IF NOT EXISTS posts (
+	post_id TEXT PRIMARY KEY,
+	subreddit_name TEXT NOT NULL,
+	content TEXT NOT NULL,
+	author TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS posts_subreddit ON posts (subreddit_name);
+CREATE TABLE IF NOT EXISTS comments (
+	comment_id TEXT PRIMARY KEY,
+	post_id TEXT NOT NULL,
+	content TEXT NOT NULL,
+	author TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS comments_post ON comments (post_id);
+CREATE TABLE IF NOT EXISTS messages (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	from_username TEXT NOT NULL,
+	to_username TEXT NOT NULL,
+	content TEXT NOT NULL,
+	timestamp INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS messages_recipient ON messages (to_username, id);
+`
+
+// SQLiteRepository stores the entities in tables of an embedded SQLite database
+type SQLiteRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteRepository(path string) (*SQLiteRepository, error) {
+	db, err := sql.Open(sqliteDriver, path)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite allows a single writer, so one connection avoids "database is locked" errors
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &SQLiteRepository{db: db}, nil
+}
+
+func (repo *SQLiteRepository) SaveUser(user *models.User) error {
+	_, err := repo.db.Exec(`INSERT OR REPLACE INTO users (username, password_hash) VALUES (?, ?)`,
+		user.Username, user.PasswordHash)
+	return err
+}
+
+func (repo *SQLiteRepository) GetUser(username string) (*models.User, error) {
+	user := &models.User{}
+	err := repo.db.QueryRow(`SELECT username, password_hash FROM users WHERE username = ?`, username).
+		Scan(&user.Username, &user.PasswordHash)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return user, nil
+}
+
+func (repo *SQLiteRepository) ListUsers() ([]*models.User, error) {
+	rows, err := repo.db.Query(`SELECT username, password_hash FROM users ORDER BY username`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []*models.User{}
+	for rows.Next() {
+		user := &models.User{}
+		if err := rows.Scan(&user.Username, &user.PasswordHash); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+func (repo *SQLiteRepository) SaveSubreddit(subreddit *models.Subreddit) error {
+	_, err := repo.db.Exec(`INSERT OR REPLACE INTO subreddits (name) VALUES (?)`, subreddit.Name)
+	return err
+}
+
+func (repo *SQLiteRepository) GetSubreddit(name string) (*models.Subreddit, error) {
+	subreddit := &models.Subreddit{}
+	err := repo.db.QueryRow(`SELECT name FROM subreddits WHERE name = ?`, name).Scan(&subreddit.Name)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return subreddit, nil
+}
+
+func (repo *SQLiteRepository) ListSubreddits() ([]*models.Subreddit, error) {
+	rows, err := repo.db.Query(`SELECT name FROM subreddits ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subreddits := []*models.Subreddit{}
+	for rows.Next() {
+		subreddit := &models.Subreddit{}
+		if err := rows.Scan(&subreddit.Name); err != nil {
+			return nil, err
+		}
+		subreddits = append(subreddits, subreddit)
+	}
+	return subreddits, rows.Err()
+}
+
+// SavePost updates an existing post in place, keeping the rowid posts are listed by
+func (repo *SQLiteRepository) SavePost(post *models.Post) error {
+	_, err := repo.db.Exec(`INSERT INTO posts (post_id, subreddit_name, content, author) VALUES (?, ?, ?, ?)
+		ON CONFLICT (post_id) DO UPDATE SET subreddit_name = excluded.subreddit_name, content = excluded.content, author = excluded.author`,
+		post.PostID, post.SubredditName, post.Content, post.Author)
+	return err
+}
+
+func (repo *SQLiteRepository) GetPost(postID string) (*models.Post, error) {
+	post := &models.Post{}
+	err := repo.db.QueryRow(`SELECT post_id, subreddit_name, content, author FROM posts WHERE post_id = ?`, postID).
+		Scan(&post.PostID, &post.SubredditName, &post.Content, &post.Author)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return post, nil
+}
+
+func (repo *SQLiteRepository) ListPosts(subredditName string) ([]*models.Post, error) {
+	rows, err := repo.db.Query(`SELECT post_id, subreddit_name, content, author FROM posts WHERE subreddit_name = ? ORDER BY rowid`, subredditName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	posts := []*models.Post{}
+	for rows.Next() {
+		post := &models.Post{}
+		if err := rows.Scan(&post.PostID, &post.SubredditName, &post.Content, &post.Author); err != nil {
+			return nil, err
+		}
+		posts = append(posts, post)
+	}
+	return posts, rows.Err()
+}
+
+// SaveComment updates an existing comment in place, keeping the rowid comments are listed by
+func (repo *SQLiteRepository) SaveComment(comment *models.Comment) error {
+	_, err := repo.db.Exec(`INSERT INTO comments (comment_id, post_id, content, author) VALUES (?, ?, ?, ?)
+		ON CONFLICT (comment_id) DO UPDATE SET post_id = excluded.post_id, content = excluded.content, author = excluded.author`,
+		comment.CommentID, comment.PostID, comment.Content, comment.Author)
+	return err
+}
+
+func (repo *SQLiteRepository) GetComment(commentID string) (*models.Comment, error) {
+	comment := &models.Comment{}
+	err := repo.db.QueryRow(`SELECT comment_id, post_id, content, author FROM comments WHERE comment_id = ?`, commentID).
+		Scan(&comment.CommentID, &comment.PostID, &comment.Content, &comment.Author)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return comment, nil
+}
+
+func (repo *SQLiteRepository) ListComments(postID string) ([]*models.Comment, error) {
+	rows, err := repo.db.Query(`SELECT comment_id, post_id, content, author FROM comments WHERE post_id = ? ORDER BY rowid`, postID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := []*models.Comment{}
+	for rows.Next() {
+		comment := &models.Comment{}
+		if err := rows.Scan(&comment.CommentID, &comment.PostID, &comment.Content, &comment.Author); err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+	return comments, rows.Err()
+}
+
+func (repo *SQLiteRepository) SaveMessage(message *models.Message) error {
+	_, err := repo.db.Exec(`INSERT INTO messages (from_username, to_username, content, timestamp) VALUES (?, ?, ?, ?)`,
+		message.FromUsername, message.ToUsername, message.Content, message.Timestamp)
+	return err
+}
+
+func (repo *SQLiteRepository) ListMessages(toUsername string) ([]*models.Message, error) {
+	rows, err := repo.db.Query(`SELECT from_username, to_username, content, timestamp FROM messages WHERE to_username = ? ORDER BY id`, toUsername)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []*models.Message{}
+	for rows.Next() {
+		message := &models.Message{}
+		if err := rows.Scan(&message.FromUsername, &message.ToUsername, &message.Content, &message.Timestamp); err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, rows.Err()
+}
+
+func (repo *SQLiteRepository) Close() error {
+	return repo.db.Close()
+}
+
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Cursor marks the last item of a page. Listings resume strictly after it in their sort
+// order, so items added since the previous page do not shift the following pages.
+type Cursor struct {
+	Score     float64 `json:"s,omitempty"`
+	Timestamp int64   `json:"t,omitempty"`
+	ID        string  `json:"i"`
+}
+
+// EncodeCursor returns the opaque string handed to clients
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor from EncodeCursor. An empty string is the first page.
+func DecodeCursor(encoded string) (*Cursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
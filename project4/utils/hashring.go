@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+)
+
+// DefaultRingReplicas is how many points each node gets on a HashRing
+const DefaultRingReplicas = 128
+
+// HashRing assigns keys to nodes by consistent hashing. Every node is placed at several
+// points on the ring and owns the keys that hash up to each of them, so adding a node only
+// takes keys from the others and never moves keys between them. Rings are not modified
+// after they are created; With returns a new one.
+type HashRing struct {
+	replicas int
+	nodes    []string
+	points   []uint64
+	owners   map[uint64]string
+}
+
+func NewHashRing(replicas int, nodes ...string) *HashRing {
+	ring := &HashRing{
+		replicas: replicas,
+		owners:   make(map[uint64]string),
+	}
+	for _, node := range nodes {
+		ring.add(node)
+	}
+	return ring
+}
+
+// With returns a copy of the ring that also has node
+func (ring *HashRing) With(node string) *HashRing {
+	return NewHashRing(ring.replicas, append(ring.Nodes(), node)...)
+}
+
+func (ring *HashRing) add(node string) {
+	ring.nodes = append(ring.nodes, node)
+	for i := 0; i < ring.replicas; i++ {
+		point := hashKey(node + "#" + strconv.Itoa(i))
+		// Ties are rare enough to settle by whichever node came first
+		if _, taken := ring.owners[point]; taken {
+			continue
+		}
+		ring.owners[point] = node
+		ring.points = append(ring.points, point)
+	}
+	sort.Slice(ring.points, func(i, j int) bool { return ring.points[i] < ring.points[j] })
+}
+
+// Nodes lists the nodes in the order they were added
+func (ring *HashRing) Nodes() []string {
+	return append([]string{}, ring.nodes...)
+}
+
+// Owner returns the node key belongs to, or "" when the ring is empty
+func (ring *HashRing) Owner(key string) string {
+	if len(ring.points) == 0 {
+		return ""
+	}
+	hash := hashKey(key)
+	i := sort.Search(len(ring.points), func(i int) bool { return ring.points[i] >= hash })
+	if i == len(ring.points) {
+		i = 0
+	}
+	return ring.owners[ring.points[i]]
+}
+
+// hashKey mixes the bits of FNV-1a, which alone leaves keys that differ in their last byte,
+// like user_1 and user_2, close together on the ring
+func hashKey(key string) uint64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(key))
+	h := hash.Sum64()
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
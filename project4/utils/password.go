@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// DefaultPasswordCost is the bcrypt work factor used unless the engine is configured otherwise
+const DefaultPasswordCost = bcrypt.DefaultCost
+
+// MaxPasswordLength is the most bytes bcrypt hashes; it rejects longer passwords
+const MaxPasswordLength = 72
+
+// ValidatePassword reports why password cannot be hashed, or nil if it can
+func ValidatePassword(password string) error {
+	if password == "" || len(password) > MaxPasswordLength {
+		return fmt.Errorf("password must be 1 to %d bytes", MaxPasswordLength)
+	}
+	return nil
+}
+
+func HashPassword(password string, cost int) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func CheckPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// NeedsRehash reports whether hash was produced with a different cost than the current one,
+// so that raising the cost takes effect as users log in
+func NeedsRehash(hash string, cost int) bool {
+	hashCost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || hashCost != cost
+}
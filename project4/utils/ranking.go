@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"math"
+	"time"
+)
+
+// Reddit's epoch for hot ranking (2005-12-08)
+const hotEpoch = 1134028003
+
+// HotScore is Reddit's hot ranking: the order of magnitude of the score plus a time bonus,
+// so every 12.5 hours of age weighs as much as a tenfold score difference
+func HotScore(upvotes, downvotes int32, timestamp int64) float64 {
+	score := float64(upvotes - downvotes)
+	order := math.Log10(math.Max(math.Abs(score), 1))
+
+	sign := 0.0
+	if score > 0 {
+		sign = 1
+	} else if score < 0 {
+		sign = -1
+	}
+
+	seconds := float64(timestamp - hotEpoch)
+	return sign*order + seconds/45000
+}
+
+// ControversyScore is Reddit's controversial ranking: many votes, evenly split, rank highest
+func ControversyScore(upvotes, downvotes int32) float64 {
+	if upvotes <= 0 || downvotes <= 0 {
+		return 0
+	}
+
+	magnitude := float64(upvotes + downvotes)
+	balance := float64(downvotes) / float64(upvotes)
+	if upvotes < downvotes {
+		balance = float64(upvotes) / float64(downvotes)
+	}
+	return math.Pow(magnitude, balance)
+}
+
+// RisingScore is the score gained per hour since the post was made, counting at least one hour
+func RisingScore(upvotes, downvotes int32, timestamp int64, now time.Time) float64 {
+	ageHours := math.Max(now.Sub(time.Unix(timestamp, 0)).Hours(), 1)
+	return float64(upvotes-downvotes) / ageHours
+}
@@ -6,6 +6,11 @@ import (
 )
 
 func NewZipfGenerator(s, v float64, imax uint64) *rand.Zipf {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	return NewSeededZipfGenerator(time.Now().UnixNano(), s, v, imax)
+}
+
+// NewSeededZipfGenerator draws the same sequence for the same seed
+func NewSeededZipfGenerator(seed int64, s, v float64, imax uint64) *rand.Zipf {
+	r := rand.New(rand.NewSource(seed))
 	return rand.NewZipf(r, s, v, imax)
 }